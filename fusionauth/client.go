@@ -12,13 +12,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const defaultRequestTimeout = 30
+
 type Client struct {
 	FAClient fusionauth.FusionAuthClient
 	Host     string
 	APIKey   string
+	// Version is the version of the FusionAuth server, detected when the
+	// provider is configured. It is empty if the connection check is skipped.
+	Version string
+	// StrictVersionCheck turns the warnings about attributes the server does
+	// not support into plan errors.
+	StrictVersionCheck bool
 }
 
-func configureClient(_ context.Context, data *schema.ResourceData) (client interface{}, diags diag.Diagnostics) {
+func configureClient(ctx context.Context, data *schema.ResourceData) (client interface{}, diags diag.Diagnostics) {
 	host := data.Get("host").(string)
 	apiKey := data.Get("api_key").(string)
 
@@ -32,17 +40,92 @@ func configureClient(_ context.Context, data *schema.ResourceData) (client inter
 		return nil, diags
 	}
 
-	client = Client{
-		Host:   host,
-		APIKey: apiKey,
-		FAClient: *fusionauth.NewClient(
-			&http.Client{
-				Timeout: time.Second * 30,
+	transport, err := configureTransport(data)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Fusionauth client",
+			Detail:   fmt.Sprintf("Unable to configure the HTTP transport: %s", err),
+		})
+		return nil, diags
+	}
+
+	retryStatusCodes := make([]int, 0)
+	for _, code := range data.Get("retry_status_codes").(*schema.Set).List() {
+		retryStatusCodes = append(retryStatusCodes, code.(int))
+	}
+
+	policy := newRetryPolicy(
+		data.Get("retry_max_attempts").(int),
+		time.Duration(data.Get("retry_wait_min").(int))*time.Second,
+		time.Duration(data.Get("retry_wait_max").(int))*time.Second,
+		retryStatusCodes,
+	)
+
+	faClient := fusionauth.NewClient(
+		&http.Client{
+			Transport: &retryTransport{
+				base:    &loggingTransport{base: transport},
+				policy:  policy,
+				timeout: time.Duration(data.Get("request_timeout").(int)) * time.Second,
 			},
-			hostURL,
-			apiKey,
-		),
+		},
+		hostURL,
+		apiKey,
+	)
+	faClient.SetTenantId(data.Get("tenant_id").(string))
+
+	var version string
+	if !data.Get("skip_connection_check").(bool) {
+		if version, diags = checkConnection(ctx, faClient); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	client = Client{
+		Host:     host,
+		APIKey:   apiKey,
+		FAClient: *faClient,
+		Version:  version,
+
+		StrictVersionCheck: data.Get("strict_version_check").(bool),
 	}
 
 	return
 }
+
+// addRequestHeaders adds the authorization and tenant headers the go-client
+// sends to a request built by hand.
+func (c Client) addRequestHeaders(req *http.Request) {
+	req.Header.Add("Authorization", c.APIKey)
+	if c.FAClient.TenantId != "" {
+		req.Header.Add("X-FusionAuth-TenantId", c.FAClient.TenantId)
+	}
+}
+
+// withContextDeadline returns a copy of the client whose requests are bounded
+// only by the deadline of the request context rather than the provider's
+// request_timeout. Resources with a timeouts block use it, so that long running
+// operations can be given more time than a regular API call.
+func (c Client) withContextDeadline() Client {
+	hc := *c.FAClient.HTTPClient
+	if rt, ok := hc.Transport.(*retryTransport); ok {
+		t := *rt
+		t.timeout = 0
+		hc.Transport = &t
+	}
+	c.FAClient.HTTPClient = &hc
+
+	return c
+}
+
+// configureTransport builds the HTTP transport shared by the go-client and the
+// provider's own HTTP requests from the provider's TLS and proxy settings.
+func configureTransport(data *schema.ResourceData) (*http.Transport, error) {
+	tc, err := buildTransportConfig(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return newHTTPTransport(tc)
+}
@@ -0,0 +1,122 @@
+package fusionauth
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// loggingTransport is a http.RoundTripper that logs every request to
+// FusionAuth. The method, path, tenant, status and duration are logged at
+// DEBUG, the request and response bodies at TRACE. Credentials are never
+// logged and secrets in the bodies are redacted.
+type loggingTransport struct {
+	base http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	fields := map[string]interface{}{
+		"method": req.Method,
+		"path":   req.URL.Path,
+	}
+	if tenantID := req.Header.Get("X-FusionAuth-TenantId"); tenantID != "" {
+		fields["tenant_id"] = tenantID
+	}
+
+	if body, ok := requestBodyForLog(req); ok {
+		tflog.Trace(ctx, "FusionAuth request body", fields, map[string]interface{}{
+			"body": body,
+		})
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		tflog.Debug(ctx, "FusionAuth request failed", fields, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	tflog.Debug(ctx, "FusionAuth request", fields)
+
+	if body, ok := responseBodyForLog(resp); ok {
+		tflog.Trace(ctx, "FusionAuth response body", fields, map[string]interface{}{
+			"body": body,
+		})
+	}
+
+	return resp, nil
+}
+
+// requestBodyForLog returns the redacted JSON body of the request, leaving the
+// request itself untouched.
+func requestBodyForLog(req *http.Request) (string, bool) {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody == nil || !isJSON(req.Header) {
+		return "", false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return "", false
+	}
+	defer body.Close()
+
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return "", false
+	}
+
+	return redactedBody(b)
+}
+
+// maxLoggedResponseSize is the size of the largest response body that is
+// logged. Larger bodies are not logged, so they are never buffered as a whole,
+// whatever the log level.
+const maxLoggedResponseSize = 64 << 10
+
+// responseBodyForLog returns the redacted JSON body of the response. The part
+// of the body that has been read is buffered, so the whole body can still be
+// read by the caller.
+func responseBodyForLog(resp *http.Response) (string, bool) {
+	if resp.Body == nil || resp.Body == http.NoBody || !isJSON(resp.Header) || resp.ContentLength > maxLoggedResponseSize {
+		return "", false
+	}
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxLoggedResponseSize+1))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(b), resp.Body), resp.Body}
+	if err != nil || len(b) > maxLoggedResponseSize {
+		return "", false
+	}
+
+	return redactedBody(b)
+}
+
+func redactedBody(b []byte) (string, bool) {
+	if len(b) == 0 {
+		return "", false
+	}
+
+	r, ok := redactJSON(b)
+	if !ok {
+		return "", false
+	}
+
+	return string(r), true
+}
+
+func isJSON(h http.Header) bool {
+	return strings.Contains(h.Get("Content-Type"), "json")
+}
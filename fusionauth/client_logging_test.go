@@ -0,0 +1,101 @@
+package fusionauth
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func Test_redactJSON(t *testing.T) {
+	in := `{
+		"apiKey": {"key": "api-key", "metaData": {"attributes": {"description": "kept"}}},
+		"user": {"email": "kept@example.com", "password": "user-password"},
+		"webhook": {"httpAuthenticationPassword": "webhook-password"},
+		"identityProvider": {"oauth2": {"client_secret": "client-secret"}},
+		"keys": [{"privateKey": "private-key"}],
+		"messenger": {"secretAccessKey": "aws-secret-access-key"},
+		"kafka": {"producer": {"bootstrap.servers": "kafka:9092", "sasl.jaas.config": "jaas-config", "ssl.key.password": "ssl-key-password"}},
+		"license": "reactor-license"
+	}`
+
+	out, ok := redactJSON([]byte(in))
+	if !ok {
+		t.Fatal("expected a JSON document")
+	}
+
+	for _, secret := range []string{"api-key", "user-password", "webhook-password", "client-secret", "private-key", "aws-secret-access-key", "jaas-config", "ssl-key-password", "reactor-license"} {
+		if strings.Contains(string(out), secret) {
+			t.Errorf("%q has not been redacted: %s", secret, out)
+		}
+	}
+	for _, kept := range []string{"kept@example.com", `"description":"kept"`, "kafka:9092"} {
+		if !strings.Contains(string(out), kept) {
+			t.Errorf("%q should not have been redacted: %s", kept, out)
+		}
+	}
+
+	if _, ok := redactJSON([]byte("not json")); ok {
+		t.Error("expected redactJSON to reject a document that is not JSON")
+	}
+}
+
+func Test_loggingTransport_preservesBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(b)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &loggingTransport{base: http.DefaultTransport}}
+
+	body := `{"user":{"password":"user-password"}}`
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/api/user", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	b, _ := io.ReadAll(resp.Body)
+	if string(b) != body {
+		t.Errorf("response body = %s, want %s", b, body)
+	}
+}
+
+func Test_responseBodyForLog_largeBody(t *testing.T) {
+	body := `{"data":"` + strings.Repeat("x", maxLoggedResponseSize) + `"}`
+	read := 0
+	resp := &http.Response{
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(readCounter{strings.NewReader(body), &read}),
+		ContentLength: -1,
+	}
+
+	if _, ok := responseBodyForLog(resp); ok {
+		t.Error("expected a body larger than maxLoggedResponseSize not to be logged")
+	}
+	if read > maxLoggedResponseSize+1 {
+		t.Errorf("read %d bytes of the body, want at most %d", read, maxLoggedResponseSize+1)
+	}
+
+	b, _ := io.ReadAll(resp.Body)
+	if string(b) != body {
+		t.Errorf("response body has %d bytes, want %d", len(b), len(body))
+	}
+}
+
+type readCounter struct {
+	r io.Reader
+	n *int
+}
+
+func (c readCounter) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	*c.n += n
+	return n, err
+}
@@ -0,0 +1,218 @@
+package fusionauth
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts = 3
+	defaultRetryWaitMin     = 1
+	defaultRetryWaitMax     = 30
+)
+
+// defaultRetryStatusCodes returns the HTTP status codes that are considered
+// transient when the provider has not been configured with its own set.
+func defaultRetryStatusCodes() []int {
+	return []int{
+		http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	}
+}
+
+// retryPolicy describes when and how often a request to FusionAuth is retried.
+type retryPolicy struct {
+	MaxAttempts int
+	WaitMin     time.Duration
+	WaitMax     time.Duration
+	StatusCodes map[int]bool
+}
+
+// newRetryPolicy builds a retry policy, falling back to the default status
+// codes if none are provided.
+func newRetryPolicy(maxAttempts int, waitMin, waitMax time.Duration, statusCodes []int) retryPolicy {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	if waitMax < waitMin {
+		waitMax = waitMin
+	}
+	if len(statusCodes) == 0 {
+		statusCodes = defaultRetryStatusCodes()
+	}
+
+	codes := make(map[int]bool, len(statusCodes))
+	for _, code := range statusCodes {
+		codes[code] = true
+	}
+
+	return retryPolicy{
+		MaxAttempts: maxAttempts,
+		WaitMin:     waitMin,
+		WaitMax:     waitMax,
+		StatusCodes: codes,
+	}
+}
+
+// backoff returns how long to wait before the given retry attempt. A
+// Retry-After header sent by FusionAuth (or a proxy in front of it) takes
+// precedence, but is still capped at WaitMax.
+func (p retryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if s, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && s >= 0 {
+			wait := time.Duration(s) * time.Second
+			if wait > p.WaitMax {
+				wait = p.WaitMax
+			}
+			return wait
+		}
+	}
+
+	wait := p.WaitMin
+	for i := 1; i < attempt && wait < p.WaitMax; i++ {
+		wait *= 2
+	}
+	if wait > p.WaitMax {
+		wait = p.WaitMax
+	}
+
+	return wait
+}
+
+// retryTransport is a http.RoundTripper that retries requests failing with a
+// transient transport error or one of the policy's retryable status codes.
+// Every request the provider makes, through the go-client or otherwise, passes
+// through it. Each attempt may take up to timeout, if set, so that retries are
+// not cut short by a timeout spanning all attempts.
+type retryTransport struct {
+	base    http.RoundTripper
+	policy  retryPolicy
+	timeout time.Duration
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var (
+		resp *http.Response
+		err  error
+	)
+
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			if req, err = rewindRequest(req); err != nil {
+				return nil, err
+			}
+		}
+
+		var written int32
+		ctx, cancel := req.Context(), context.CancelFunc(func() {})
+		if t.timeout > 0 {
+			ctx, cancel = context.WithTimeout(req.Context(), t.timeout)
+		}
+		ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+			WroteRequest: func(httptrace.WroteRequestInfo) {
+				atomic.StoreInt32(&written, 1)
+			},
+		})
+
+		resp, err = t.base.RoundTrip(req.WithContext(ctx))
+		if attempt >= t.policy.MaxAttempts || !t.shouldRetry(req, resp, err, atomic.LoadInt32(&written) == 1) {
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+			// The attempt lasts until its body has been read.
+			resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+			return resp, nil
+		}
+
+		wait := t.policy.backoff(attempt, resp)
+		if resp != nil {
+			// Drain the body so the underlying connection can be reused.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		cancel()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether a failed attempt is retried. Only idempotent
+// requests are retried on any transient failure. A POST or PATCH, such as
+// creating an object, may already have taken effect, so it is only retried
+// if FusionAuth turned it away with 429 or 503, or if the connection failed
+// before the request was written.
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error, written bool) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body has been consumed and cannot be replayed.
+		return false
+	}
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return isIdempotent(req.Method) || !written
+	}
+
+	if !t.policy.StatusCodes[resp.StatusCode] {
+		return false
+	}
+
+	return isIdempotent(req.Method) ||
+		resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode == http.StatusServiceUnavailable
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// cancelOnClose cancels the context of an attempt once its response body has
+// been closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+
+	return err
+}
+
+// rewindRequest returns a copy of the request with a fresh body, so it can be
+// sent again.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	if req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	r := req.Clone(req.Context())
+	r.Body = body
+	return r, nil
+}
@@ -0,0 +1,219 @@
+package fusionauth
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"testing"
+	"time"
+)
+
+func Test_retryTransport(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		statuses     []int
+		maxAttempts  int
+		wantStatus   int
+		wantAttempts int
+	}{
+		{
+			name:         "succeeds first time",
+			statuses:     []int{http.StatusOK},
+			maxAttempts:  3,
+			wantStatus:   http.StatusOK,
+			wantAttempts: 1,
+		},
+		{
+			name:         "retries transient failures",
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			maxAttempts:  3,
+			wantStatus:   http.StatusOK,
+			wantAttempts: 3,
+		},
+		{
+			name:         "gives up after max attempts",
+			method:       http.MethodPut,
+			statuses:     []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			maxAttempts:  2,
+			wantStatus:   http.StatusBadGateway,
+			wantAttempts: 2,
+		},
+		{
+			name:         "does not retry a post that may have taken effect",
+			statuses:     []int{http.StatusBadGateway, http.StatusOK},
+			maxAttempts:  3,
+			wantStatus:   http.StatusBadGateway,
+			wantAttempts: 1,
+		},
+		{
+			name:         "retries a gateway timeout of an idempotent request",
+			method:       http.MethodDelete,
+			statuses:     []int{http.StatusGatewayTimeout, http.StatusOK},
+			maxAttempts:  3,
+			wantStatus:   http.StatusOK,
+			wantAttempts: 2,
+		},
+		{
+			name:         "does not retry client errors",
+			statuses:     []int{http.StatusBadRequest, http.StatusOK},
+			maxAttempts:  3,
+			wantStatus:   http.StatusBadRequest,
+			wantAttempts: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, _ := io.ReadAll(r.Body)
+				if string(b) != `{"hello":"world"}` {
+					t.Errorf("attempt %d: unexpected body %q", attempts+1, string(b))
+				}
+				w.WriteHeader(tt.statuses[attempts])
+				attempts++
+			}))
+			defer server.Close()
+
+			hc := &http.Client{
+				Transport: &retryTransport{
+					base:   http.DefaultTransport,
+					policy: newRetryPolicy(tt.maxAttempts, time.Millisecond, time.Millisecond, nil),
+				},
+			}
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req, _ := http.NewRequest(method, server.URL, bytes.NewBufferString(`{"hello":"world"}`))
+			resp, err := hc.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func Test_retryTransport_connectionErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		written      bool
+		wantAttempts int
+	}{
+		{name: "retries a post that was not sent", method: http.MethodPost, wantAttempts: 3},
+		{name: "does not retry a post that was sent", method: http.MethodPost, written: true, wantAttempts: 1},
+		{name: "retries a get that was sent", method: http.MethodGet, written: true, wantAttempts: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				attempts++
+				if tt.written {
+					if trace := httptrace.ContextClientTrace(req.Context()); trace != nil && trace.WroteRequest != nil {
+						trace.WroteRequest(httptrace.WroteRequestInfo{})
+					}
+				}
+				return nil, errors.New("connection reset by peer")
+			})
+			hc := &http.Client{
+				Transport: &retryTransport{
+					base:   base,
+					policy: newRetryPolicy(3, time.Millisecond, time.Millisecond, nil),
+				},
+			}
+
+			req, _ := http.NewRequest(tt.method, "http://fusionauth.example.com/api/user", nil)
+			if _, err := hc.Do(req); err == nil {
+				t.Fatal("expected an error")
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func Test_retryTransport_timeoutPerAttempt(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			<-r.Context().Done()
+			return
+		}
+		_, _ = w.Write([]byte(`{"hello":"world"}`))
+	}))
+	defer server.Close()
+
+	hc := &http.Client{
+		Transport: &retryTransport{
+			base:    http.DefaultTransport,
+			policy:  newRetryPolicy(3, 50*time.Millisecond, 50*time.Millisecond, nil),
+			timeout: 100 * time.Millisecond,
+		},
+	}
+
+	resp, err := hc.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	// The body can still be read, as the timeout of the attempt only ends
+	// once it has been closed.
+	b, err := io.ReadAll(resp.Body)
+	if err != nil || string(b) != `{"hello":"world"}` {
+		t.Errorf("body = %q, %v", string(b), err)
+	}
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want 2", attempts)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func Test_retryPolicy_backoff(t *testing.T) {
+	p := newRetryPolicy(5, time.Second, 5*time.Second, nil)
+
+	tests := []struct {
+		name       string
+		attempt    int
+		retryAfter string
+		want       time.Duration
+	}{
+		{name: "first retry", attempt: 1, want: time.Second},
+		{name: "doubles", attempt: 3, want: 4 * time.Second},
+		{name: "capped", attempt: 4, want: 5 * time.Second},
+		{name: "retry after", attempt: 1, retryAfter: "2", want: 2 * time.Second},
+		{name: "retry after capped", attempt: 1, retryAfter: "60", want: 5 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tt.retryAfter != "" {
+				resp.Header.Set("Retry-After", tt.retryAfter)
+			}
+			if got := p.backoff(tt.attempt, resp); got != tt.want {
+				t.Errorf("backoff() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package fusionauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// checkConnection verifies that FusionAuth is reachable and healthy and that
+// the API key is accepted, returning the version of the server.
+func checkConnection(ctx context.Context, faClient *fusionauth.FusionAuthClient) (version string, diags diag.Diagnostics) {
+	status := &fusionauth.BaseHTTPResponse{}
+	err := faClient.StartAnonymous(status, &fusionauth.Errors{}).
+		WithUri("/api/status").
+		WithMethod(http.MethodGet).
+		Do(ctx)
+	if err != nil {
+		return "", append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to connect to FusionAuth",
+			Detail:   fmt.Sprintf("The request to %s/api/status failed: %s", faClient.BaseURL, err),
+		})
+	}
+	if status.StatusCode != http.StatusOK {
+		return "", append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "FusionAuth is not healthy",
+			Detail:   fmt.Sprintf("%s/api/status returned status code %d. Check the FusionAuth logs for details.", faClient.BaseURL, status.StatusCode),
+		})
+	}
+
+	resp, faErrs, err := faClient.RetrieveVersionWithContext(ctx)
+	if err != nil {
+		return "", diag.Errorf("RetrieveVersion err: %v", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Version, nil
+	case http.StatusUnauthorized:
+		return "", append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "The API key was rejected by FusionAuth",
+			Detail: "Check that the API key exists, belongs to this instance and is allowed to call GET /api/system/version. " +
+				"Set skip_connection_check to true to use an API key restricted to other endpoints.",
+		})
+	default:
+		return "", diag.FromErr(checkResponse(resp.StatusCode, faErrs))
+	}
+}
+
+// versionAtLeast reports whether the FusionAuth server is at least the given
+// version. If the version is not known, i.e. the connection check has been
+// skipped, the server is assumed to be recent enough.
+func (c Client) versionAtLeast(version string) bool {
+	if c.Version == "" {
+		return true
+	}
+
+	return compareVersions(c.Version, version) >= 0
+}
+
+// compareVersions compares two dotted version strings, such as "1.41.0",
+// returning -1, 0 or 1. Any pre-release suffix is ignored.
+func compareVersions(a, b string) int {
+	as, bs := versionParts(a), versionParts(b)
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x = as[i]
+		}
+		if i < len(bs) {
+			y = bs[i]
+		}
+
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func versionParts(version string) []int {
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+
+	fields := strings.Split(version, ".")
+	parts := make([]int, 0, len(fields))
+	for _, f := range fields {
+		n, _ := strconv.Atoi(f)
+		parts = append(parts, n)
+	}
+
+	return parts
+}
+
+// attributeGetter is implemented by both schema.ResourceData and
+// schema.ResourceDiff.
+type attributeGetter interface {
+	Get(key string) interface{}
+	GetOk(key string) (interface{}, bool)
+}
+
+// customizeDiffMinVersions fails the plan, if the provider is configured with
+// strict_version_check, for the attributes unsupportedAttributeWarnings
+// otherwise warns about on apply.
+func customizeDiffMinVersions(minVersions func() map[string]string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, i interface{}) error {
+		client, ok := i.(Client)
+		if !ok || !client.StrictVersionCheck {
+			return nil
+		}
+
+		return unsupportedVersionError(unsupportedAttributeWarnings(diff, client, minVersions()))
+	}
+}
+
+// customizeDiffResourceMinVersion fails the plan for a resource that requires
+// a newer FusionAuth version than the one the provider is connected to. Unlike
+// unsupported attributes, which the server ignores, such a resource cannot be
+// managed at all, so the plan fails regardless of strict_version_check.
+func customizeDiffResourceMinVersion(resourceType, minVersion string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, _ *schema.ResourceDiff, i interface{}) error {
+		client, ok := i.(Client)
+		if !ok || client.versionAtLeast(minVersion) {
+			return nil
+		}
+
+		return fmt.Errorf(
+			"%s requires FusionAuth %s or later, but the provider is connected to FusionAuth %s",
+			resourceType, minVersion, client.Version,
+		)
+	}
+}
+
+// unsupportedAttributeWarnings returns a warning for every configured
+// attribute that requires a newer FusionAuth version than the one the
+// provider is connected to. Attributes are given as flatmap keys, such as
+// "events_enabled.0.user_identity_provider_link", mapped to the version that
+// introduced them.
+func unsupportedAttributeWarnings(data attributeGetter, client Client, minVersions map[string]string) (diags diag.Diagnostics) {
+	keys := make([]string, 0, len(minVersions))
+	for k := range minVersions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if client.versionAtLeast(minVersions[k]) {
+			continue
+		}
+		if _, ok := data.GetOk(k); !ok {
+			continue
+		}
+
+		diags = append(diags, unsupportedVersionWarning(client, k, minVersions[k]))
+	}
+
+	return diags
+}
+
+// unsupportedVersionWarning returns a warning for an attribute that is not
+// supported by the connected FusionAuth server.
+func unsupportedVersionWarning(client Client, key, minVersion string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s is not supported by FusionAuth %s", key, client.Version),
+		Detail: fmt.Sprintf(
+			"%s requires FusionAuth %s or later and will likely be ignored by the server. "+
+				"Upgrade FusionAuth or remove the attribute from the configuration.",
+			key, minVersion,
+		),
+		AttributePath: flatmapKeyToPath(key),
+	}
+}
+
+// unsupportedVersionError returns an error made of the unsupported version
+// warnings, or nil if there are none.
+func unsupportedVersionError(warnings diag.Diagnostics) error {
+	if len(warnings) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, 0, len(warnings))
+	for _, w := range warnings {
+		msgs = append(msgs, w.Summary+": "+w.Detail)
+	}
+
+	return errors.New(strings.Join(msgs, "\n"))
+}
+
+// flatmapKeyToPath converts a flatmap key, such as "jwt_configuration.0.enabled",
+// to an attribute path.
+func flatmapKeyToPath(key string) (path cty.Path) {
+	for _, step := range strings.Split(key, ".") {
+		if i, err := strconv.Atoi(step); err == nil {
+			path = path.IndexInt(i)
+			continue
+		}
+		path = path.GetAttr(step)
+	}
+
+	return path
+}
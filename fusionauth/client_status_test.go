@@ -0,0 +1,126 @@
+package fusionauth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func Test_compareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "1.41.0", b: "1.41.0", want: 0},
+		{a: "1.41.1", b: "1.41.0", want: 1},
+		{a: "1.9.0", b: "1.41.0", want: -1},
+		{a: "1.41", b: "1.41.0", want: 0},
+		{a: "1.50.0-SNAPSHOT", b: "1.50.0", want: 0},
+		{a: "2.0.0", b: "1.99.99", want: 1},
+	}
+	for _, tt := range tests {
+		if got := compareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func Test_checkConnection(t *testing.T) {
+	tests := []struct {
+		name          string
+		statusCode    int
+		versionCode   int
+		wantVersion   string
+		wantErrorText string
+	}{
+		{
+			name:        "healthy",
+			statusCode:  http.StatusOK,
+			versionCode: http.StatusOK,
+			wantVersion: "1.48.3",
+		},
+		{
+			name:          "unhealthy",
+			statusCode:    460,
+			wantErrorText: "FusionAuth is not healthy",
+		},
+		{
+			name:          "rejected API key",
+			statusCode:    http.StatusOK,
+			versionCode:   http.StatusUnauthorized,
+			wantErrorText: "The API key was rejected by FusionAuth",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/status":
+					w.WriteHeader(tt.statusCode)
+				case "/api/system/version":
+					w.WriteHeader(tt.versionCode)
+					if tt.versionCode == http.StatusOK {
+						_, _ = w.Write([]byte(`{"version":"1.48.3"}`))
+					}
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			u, _ := url.Parse(server.URL)
+			version, diags := checkConnection(context.Background(), fusionauth.NewClient(server.Client(), u, "key"))
+
+			if tt.wantErrorText == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %#v", diags)
+				}
+				if version != tt.wantVersion {
+					t.Errorf("version = %q, want %q", version, tt.wantVersion)
+				}
+				return
+			}
+
+			if !diags.HasError() || diags[0].Summary != tt.wantErrorText {
+				t.Errorf("diags = %#v, want error %q", diags, tt.wantErrorText)
+			}
+		})
+	}
+}
+
+func Test_unsupportedAttributeWarnings(t *testing.T) {
+	data := newTheme().TestResourceData()
+	_ = data.Set("account_webauthn_add", "[#ftl/]")
+
+	if diags := unsupportedAttributeWarnings(data, Client{Version: "1.40.2"}, themeMinVersions()); len(diags) != 1 {
+		t.Errorf("expected one warning for an old server, got %#v", diags)
+	}
+	if diags := unsupportedAttributeWarnings(data, Client{Version: "1.41.0"}, themeMinVersions()); len(diags) != 0 {
+		t.Errorf("expected no warnings for a recent server, got %#v", diags)
+	}
+	if diags := unsupportedAttributeWarnings(data, Client{}, themeMinVersions()); len(diags) != 0 {
+		t.Errorf("expected no warnings for an unknown version, got %#v", diags)
+	}
+}
+
+func Test_customizeDiffMinVersions(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_webauthn_add": "[#ftl/]",
+		"name":                 "theme",
+	})
+
+	if _, err := newTheme().Diff(context.Background(), nil, config, Client{Version: "1.40.2"}); err != nil {
+		t.Errorf("unexpected error planning an unsupported attribute without strict_version_check: %v", err)
+	}
+	if _, err := newTheme().Diff(context.Background(), nil, config, Client{Version: "1.40.2", StrictVersionCheck: true}); err == nil {
+		t.Error("expected an error planning an unsupported attribute with strict_version_check")
+	}
+	if _, err := newTheme().Diff(context.Background(), nil, config, Client{Version: "1.41.0", StrictVersionCheck: true}); err != nil {
+		t.Errorf("unexpected error for a recent server: %v", err)
+	}
+}
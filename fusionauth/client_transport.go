@@ -0,0 +1,110 @@
+package fusionauth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// transportConfig holds the TLS and proxy settings used to build the HTTP
+// transport shared by every request the provider makes.
+type transportConfig struct {
+	CACertPEM          string
+	ClientCertPEM      string
+	ClientKeyPEM       string
+	InsecureSkipVerify bool
+	ProxyURL           string
+}
+
+// buildTransportConfig reads the TLS and proxy settings from the provider
+// configuration. PEM files are read from disk here, so that the rest of the
+// transport setup only has to deal with PEM content.
+func buildTransportConfig(data *schema.ResourceData) (cfg transportConfig, err error) {
+	cfg = transportConfig{
+		InsecureSkipVerify: data.Get("insecure_skip_verify").(bool),
+		ProxyURL:           data.Get("proxy_url").(string),
+	}
+
+	if cfg.CACertPEM, err = pemFromConfig(data, "ca_cert_pem", "ca_cert_file"); err != nil {
+		return cfg, err
+	}
+	if cfg.ClientCertPEM, err = pemFromConfig(data, "client_cert_pem", "client_cert_file"); err != nil {
+		return cfg, err
+	}
+	if cfg.ClientKeyPEM, err = pemFromConfig(data, "client_key_pem", "client_key_file"); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
+
+// pemFromConfig returns the PEM content for a setting that can be provided
+// either inline or as a path to a file.
+func pemFromConfig(data *schema.ResourceData, pemKey, fileKey string) (string, error) {
+	if pem := data.Get(pemKey).(string); pem != "" {
+		return pem, nil
+	}
+
+	path := data.Get(fileKey).(string)
+	if path == "" {
+		return "", nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read %s: %w", fileKey, err)
+	}
+
+	return string(b), nil
+}
+
+// newHTTPTransport builds the transport used for all requests to FusionAuth.
+func newHTTPTransport(cfg transportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// Only intended for local development against self signed instances.
+		InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec
+	}
+
+	if cfg.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(cfg.CACertPEM)) {
+			return nil, errors.New("no valid certificates found in the provided CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	switch {
+	case cfg.ClientCertPEM != "" && cfg.ClientKeyPEM != "":
+		cert, err := tls.X509KeyPair([]byte(cfg.ClientCertPEM), []byte(cfg.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	case cfg.ClientCertPEM != "" || cfg.ClientKeyPEM != "":
+		return nil, errors.New("a client certificate and a client key must be provided together")
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	if cfg.ProxyURL != "" {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the proxy URL: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return transport, nil
+}
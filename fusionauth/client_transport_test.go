@@ -0,0 +1,70 @@
+package fusionauth
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func Test_newHTTPTransport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	serverCA := string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	}))
+
+	tests := []struct {
+		name            string
+		cfg             transportConfig
+		wantConfigErr   bool
+		wantRequestFail bool
+	}{
+		{
+			name:            "rejects unknown CA",
+			cfg:             transportConfig{},
+			wantRequestFail: true,
+		},
+		{
+			name: "trusts provided CA",
+			cfg:  transportConfig{CACertPEM: serverCA},
+		},
+		{
+			name: "skips verification",
+			cfg:  transportConfig{InsecureSkipVerify: true},
+		},
+		{
+			name:          "invalid CA bundle",
+			cfg:           transportConfig{CACertPEM: "not a certificate"},
+			wantConfigErr: true,
+		},
+		{
+			name:          "client certificate without key",
+			cfg:           transportConfig{ClientCertPEM: serverCA},
+			wantConfigErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport, err := newHTTPTransport(tt.cfg)
+			if (err != nil) != tt.wantConfigErr {
+				t.Fatalf("newHTTPTransport() error = %v, wantConfigErr %v", err, tt.wantConfigErr)
+			}
+			if err != nil {
+				return
+			}
+
+			resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if (err != nil) != tt.wantRequestFail {
+				t.Errorf("request error = %v, wantRequestFail %v", err, tt.wantRequestFail)
+			}
+		})
+	}
+}
@@ -22,10 +22,10 @@ func dataSourceApplication() *schema.Resource {
 	}
 }
 
-func dataSourceApplicationRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func dataSourceApplicationRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
-	resp, err := client.FAClient.RetrieveApplications()
+	resp, err := client.FAClient.RetrieveApplicationsWithContext(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
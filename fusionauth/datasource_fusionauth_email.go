@@ -73,10 +73,10 @@ func dataSourceEmail() *schema.Resource {
 	}
 }
 
-func dataSourceEmailRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func dataSourceEmailRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
-	resp, err := client.FAClient.RetrieveEmailTemplates()
+	resp, err := client.FAClient.RetrieveEmailTemplatesWithContext(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -67,7 +67,7 @@ func dataSourceForm() *schema.Resource {
 	}
 }
 
-func dataSourceFormRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func dataSourceFormRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
 	var searchTerm string
@@ -77,10 +77,10 @@ func dataSourceFormRead(_ context.Context, data *schema.ResourceData, i interfac
 	// Either `form_id` or `name` are guaranteed to be set
 	if entityID, ok := data.GetOk("form_id"); ok {
 		searchTerm = entityID.(string)
-		res, err = client.FAClient.RetrieveForm(searchTerm)
+		res, err = client.FAClient.RetrieveFormWithContext(ctx, searchTerm)
 	} else {
 		searchTerm = data.Get("name").(string)
-		res, err = client.FAClient.RetrieveForms()
+		res, err = client.FAClient.RetrieveFormsWithContext(ctx)
 	}
 	if err != nil {
 		return diag.FromErr(err)
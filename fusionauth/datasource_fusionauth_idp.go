@@ -2,28 +2,13 @@ package fusionauth
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
-	"net/http"
-	"strings"
-	"time"
 
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-type IdentityProvidersResponse struct {
-	IdentityProviders []IdentityProvider `json:"identityProviders"`
-}
-
-type IdentityProvider struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-	Type string `json:"type"`
-}
-
 func dataSourceIDP() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceIDPRead,
@@ -53,15 +38,15 @@ func dataSourceIDP() *schema.Resource {
 	}
 }
 
-func dataSourceIDPRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func dataSourceIDPRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	b, err := readIdentityProviders(client)
+	resp, faErrs, err := retrieveIdentityProviders(ctx, client)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	var idps IdentityProvidersResponse
-	_ = json.Unmarshal(b, &idps)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
 
 	n := data.Get("name").(string)
 	t := data.Get("type").(string)
@@ -69,47 +54,16 @@ func dataSourceIDPRead(_ context.Context, data *schema.ResourceData, i interface
 	case "Apple", "Facebook", "Google", "HYPR", "Twitter":
 		n = t
 	}
-	var idp *IdentityProvider
-	for i := range idps.IdentityProviders {
-		if idps.IdentityProviders[i].Name == n && idps.IdentityProviders[i].Type == t {
-			idp = &idps.IdentityProviders[i]
+	var idp *fusionauth.BaseIdentityProvider
+	for i := range resp.IdentityProviders {
+		if resp.IdentityProviders[i].Name == n && string(resp.IdentityProviders[i].Type) == t {
+			idp = &resp.IdentityProviders[i]
 		}
 	}
 
 	if idp == nil {
 		return diag.Errorf("couldn't find identity provider name %s, type %s", n, t)
 	}
-	data.SetId(idp.ID)
+	data.SetId(idp.Id)
 	return nil
 }
-
-func readIdentityProviders(client Client) ([]byte, error) {
-	req, err := http.NewRequest(
-		http.MethodGet,
-		fmt.Sprintf("%s/%s", strings.TrimRight(client.Host, "/"), "api/identity-provider"),
-		nil,
-	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Authorization", client.APIKey)
-
-	hc := http.Client{
-		Timeout: 30 * time.Second,
-	}
-
-	resp, err := hc.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	b, _ := io.ReadAll(resp.Body)
-
-	if err := checkResponse(resp.StatusCode, nil); err != nil {
-		return b, err
-	}
-	return b, nil
-}
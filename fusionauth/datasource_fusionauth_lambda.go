@@ -65,6 +65,10 @@ func dataSourceLambda() *schema.Resource {
 					string(fusionauth.LambdaType_XboxReconcile),
 					string(fusionauth.LambdaType_SelfServiceRegistrationValidation),
 					string(fusionauth.LambdaType_ClientCredentialsJWTPopulate),
+					string(fusionauth.LambdaType_SCIMServerGroupRequestConverter),
+					string(fusionauth.LambdaType_SCIMServerGroupResponseConverter),
+					string(fusionauth.LambdaType_SCIMServerUserRequestConverter),
+					string(fusionauth.LambdaType_SCIMServerUserResponseConverter),
 				}, false),
 				Description: "The Lambda type.",
 			},
@@ -72,11 +76,11 @@ func dataSourceLambda() *schema.Resource {
 	}
 }
 
-func dataSourceLambdaRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func dataSourceLambdaRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
 	lambdaType := data.Get("type").(string)
-	resp, err := client.FAClient.RetrieveLambdasByType(fusionauth.LambdaType(lambdaType))
+	resp, err := client.FAClient.RetrieveLambdasByTypeWithContext(ctx, fusionauth.LambdaType(lambdaType))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,74 @@
+package fusionauth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMessageTemplate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMessageTemplateRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the Message Template.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique Id of the Message Template",
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "An object that can hold any information about the Message Template that should be persisted.",
+			},
+			"default_template": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The default Message Template.",
+			},
+			"localized_templates": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The Message Template used when sending messages to users who speak other languages.",
+			},
+		},
+	}
+}
+
+func dataSourceMessageTemplateRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	// The go-client only models the properties shared by all types of message
+	// templates, so the templates of SMS messages would be dropped.
+	templates, diags := makeTypedRequest[[]fusionauth.SMSMessageTemplate](ctx, data, client, messageTemplatesAPI, "", nil, http.MethodGet)
+	if diags != nil {
+		return diags
+	}
+	name := data.Get("name").(string)
+	var t *fusionauth.SMSMessageTemplate
+
+	if templates != nil {
+		for i := range *templates {
+			if (*templates)[i].Name == name {
+				t = &(*templates)[i]
+				break
+			}
+		}
+	}
+	if t == nil {
+		return diag.Errorf("couldn't find message template %s", name)
+	}
+	data.SetId(t.Id)
+
+	return buildResourceDataFromMessageTemplate(data, *t)
+}
@@ -22,10 +22,10 @@ func dataSourceTenant() *schema.Resource {
 	}
 }
 
-func dataSourceTenantRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func dataSourceTenantRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
-	resp, err := client.FAClient.RetrieveTenants()
+	resp, err := client.FAClient.RetrieveTenantsWithContext(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
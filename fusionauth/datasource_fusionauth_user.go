@@ -121,14 +121,11 @@ func dataSourceUser() *schema.Resource {
 	}
 }
 
-func dataSourceUserRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func dataSourceUserRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
-	oldTenantID := client.FAClient.TenantId
-	client.FAClient.TenantId = data.Get("tenant_id").(string)
-	defer func() {
-		client.FAClient.TenantId = oldTenantID
-	}()
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
 
 	var searchID string
 	var resp *fusionauth.UserResponse
@@ -138,10 +135,10 @@ func dataSourceUserRead(_ context.Context, data *schema.ResourceData, i interfac
 	// Either `user_id` or `username` are guaranteed to be set
 	if userID, ok := data.GetOk("user_id"); ok {
 		searchID = userID.(string)
-		resp, faErrs, err = client.FAClient.RetrieveUser(searchID)
+		resp, faErrs, err = client.FAClient.RetrieveUserWithContext(ctx, searchID)
 	} else {
 		searchID = data.Get("username").(string)
-		resp, faErrs, err = client.FAClient.RetrieveUserByUsername(searchID)
+		resp, faErrs, err = client.FAClient.RetrieveUserByUsernameWithContext(ctx, searchID)
 	}
 	if err != nil {
 		return diag.FromErr(err)
@@ -149,8 +146,8 @@ func dataSourceUserRead(_ context.Context, data *schema.ResourceData, i interfac
 	if resp.StatusCode == http.StatusNotFound {
 		return diag.Errorf("couldn't find %s", searchID)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	data.SetId(resp.User.Id)
@@ -0,0 +1,567 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// exportedObject is an object of a FusionAuth instance to be exported as a
+// Terraform resource.
+type exportedObject struct {
+	// ResourceType is the type of the Terraform resource, i.e.
+	// "fusionauth_tenant".
+	ResourceType string
+	// Name is used to derive the name of the Terraform resource.
+	Name string
+	// ID is the ID of the object.
+	ID string
+	// ImportID is the ID the resource is imported by, if it differs from ID.
+	ImportID string
+	// Attributes are set before the resource is read, for resources that
+	// cannot be read by their ID alone.
+	Attributes map[string]interface{}
+}
+
+// exportLister lists the objects of one kind in a FusionAuth instance.
+type exportLister func(ctx context.Context, client Client) ([]exportedObject, error)
+
+// exportListers returns the listers for all kinds of objects that can be
+// exported, in the order they are written.
+func exportListers() []exportLister {
+	return []exportLister{
+		listTenantsForExport,
+		listApplicationsForExport,
+		listKeysForExport,
+		listThemesForExport,
+		listEmailTemplatesForExport,
+		listLambdasForExport,
+		listWebhooksForExport,
+		listIdentityProvidersForExport,
+		listFormFieldsForExport,
+		listFormsForExport,
+		listGroupsForExport,
+	}
+}
+
+// Export reads the configuration of a FusionAuth instance and writes it to w
+// as Terraform configuration, with an import block for every resource. The
+// provider is configured from config the same way Terraform would, falling
+// back to the environment, i.e. FA_DOMAIN and FA_API_KEY.
+func Export(ctx context.Context, w io.Writer, config map[string]interface{}) diag.Diagnostics {
+	p := Provider()
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		return diags
+	}
+	client := p.Meta().(Client)
+
+	var diags diag.Diagnostics
+	var resources []exportedResource
+	labels := make(map[string]bool)
+	refs := make(exportReferences)
+
+	for _, list := range exportListers() {
+		objects, err := list(ctx, client)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		for _, o := range objects {
+			r := p.ResourcesMap[o.ResourceType]
+			data := r.Data(nil)
+			data.SetId(o.ID)
+			for k, v := range o.Attributes {
+				if err := data.Set(k, v); err != nil {
+					return append(diags, diag.Errorf("%s.%s: %s", o.ResourceType, k, err.Error())...)
+				}
+			}
+
+			readDiags := r.ReadContext(ctx, data, client)
+			for i := range readDiags {
+				readDiags[i].Summary = fmt.Sprintf("%s %s: %s", o.ResourceType, o.ID, readDiags[i].Summary)
+			}
+			diags = append(diags, readDiags...)
+			if readDiags.HasError() || data.Id() == "" {
+				continue
+			}
+
+			importID := o.ImportID
+			if importID == "" {
+				importID = o.ID
+			}
+			label := exportLabel(o.ResourceType, o.Name, labels)
+			refs[o.ID] = hcl.Traversal{
+				hcl.TraverseRoot{Name: o.ResourceType},
+				hcl.TraverseAttr{Name: label},
+				hcl.TraverseAttr{Name: "id"},
+			}
+			resources = append(resources, exportedResource{
+				resource:     r,
+				resourceType: o.ResourceType,
+				label:        label,
+				importID:     importID,
+				data:         data,
+			})
+		}
+	}
+
+	// Resources are written once all of them are read, so that IDs of objects
+	// exported later can be written as references too.
+	file := hclwrite.NewEmptyFile()
+	for _, res := range resources {
+		writeExportedResource(file.Body(), res, refs)
+	}
+
+	_, err := w.Write(hclwrite.Format(file.Bytes()))
+	return append(diags, diag.FromErr(err)...)
+}
+
+// exportedResource is an exported object as read by its Terraform resource.
+type exportedResource struct {
+	resource     *schema.Resource
+	resourceType string
+	label        string
+	importID     string
+	data         *schema.ResourceData
+}
+
+// exportReferences maps the IDs of exported objects to a reference to the ID
+// of the resource they are exported as, i.e. fusionauth_tenant.default.id.
+type exportReferences map[string]hcl.Traversal
+
+// writeExportedResource appends an import block and the resource block for the
+// resource to body. Values equal to the ID of another exported object are
+// written as references to that object.
+func writeExportedResource(body *hclwrite.Body, res exportedResource, refs exportReferences) {
+	importBlock := body.AppendNewBlock("import", nil)
+	importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: res.resourceType},
+		hcl.TraverseAttr{Name: res.label},
+	})
+	importBlock.Body().SetAttributeValue("id", cty.StringVal(res.importID))
+	body.AppendNewline()
+
+	values := make(map[string]interface{}, len(res.resource.Schema))
+	for k := range res.resource.Schema {
+		values[k] = res.data.Get(k)
+	}
+
+	resourceBlock := body.AppendNewBlock("resource", []string{res.resourceType, res.label})
+	writeExportedAttributes(resourceBlock.Body(), res.resource.Schema, values, refs, res.data.Id())
+	body.AppendNewline()
+}
+
+// writeExportedAttributes writes the values of a resource, or of a nested
+// block, using its schema. Computed only attributes and values equal to the
+// default are left out. Sensitive values are never written, as FusionAuth does
+// not return most of them anyway, instead a comment points them out. The ID of
+// the resource itself, selfID, is never written as a reference, i.e. the
+// tenant_id of a tenant.
+func writeExportedAttributes(body *hclwrite.Body, s map[string]*schema.Schema, values map[string]interface{}, refs exportReferences, selfID string) {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		sch := s[k]
+		if sch.Computed && !sch.Optional && !sch.Required {
+			continue
+		}
+
+		v := values[k]
+		if set, ok := v.(*schema.Set); ok {
+			v = set.List()
+		}
+
+		if elem, ok := sch.Elem.(*schema.Resource); ok {
+			items, _ := v.([]interface{})
+			for _, item := range items {
+				m, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				block := body.AppendNewBlock(k, nil)
+				writeExportedAttributes(block.Body(), elem.Schema, m, refs, selfID)
+			}
+			continue
+		}
+
+		if isExportDefault(sch, v) {
+			continue
+		}
+
+		if sch.Sensitive {
+			body.AppendUnstructuredTokens(hclwrite.Tokens{{
+				Type:  hclsyntax.TokenComment,
+				Bytes: []byte(fmt.Sprintf("# %s is sensitive and has not been exported.\n", k)),
+			}})
+			continue
+		}
+
+		if tokens, ok := exportTokens(sch, v, refs, selfID); ok {
+			body.SetAttributeRaw(k, tokens)
+		}
+	}
+}
+
+// isExportDefault reports whether v is the value an attribute has when it is
+// not configured, so it can be left out.
+func isExportDefault(sch *schema.Schema, v interface{}) bool {
+	if sch.Default != nil {
+		return fmt.Sprint(sch.Default) == fmt.Sprint(v)
+	}
+
+	switch value := v.(type) {
+	case nil:
+		return true
+	case string:
+		return value == ""
+	case int:
+		return value == 0
+	case float64:
+		return value == 0
+	case bool:
+		return !value
+	case []interface{}:
+		return len(value) == 0
+	case map[string]interface{}:
+		return len(value) == 0
+	}
+
+	return false
+}
+
+// exportTokens converts the value of an attribute, as returned by
+// schema.ResourceData, to the tokens of an expression. Strings equal to the ID
+// of an exported object other than selfID are converted to a reference to it.
+func exportTokens(sch *schema.Schema, v interface{}, refs exportReferences, selfID string) (hclwrite.Tokens, bool) {
+	switch sch.Type {
+	case schema.TypeString:
+		s, ok := v.(string)
+		if ref, isRef := refs[s]; ok && isRef && s != selfID {
+			return hclwrite.TokensForTraversal(ref), true
+		}
+		return hclwrite.TokensForValue(cty.StringVal(s)), ok
+	case schema.TypeInt:
+		i, ok := v.(int)
+		return hclwrite.TokensForValue(cty.NumberIntVal(int64(i))), ok
+	case schema.TypeFloat:
+		f, ok := v.(float64)
+		return hclwrite.TokensForValue(cty.NumberFloatVal(f)), ok
+	case schema.TypeBool:
+		b, ok := v.(bool)
+		return hclwrite.TokensForValue(cty.BoolVal(b)), ok
+	case schema.TypeList, schema.TypeSet:
+		items, ok := v.([]interface{})
+		if !ok {
+			return nil, false
+		}
+		elem, _ := sch.Elem.(*schema.Schema)
+		if elem == nil {
+			elem = &schema.Schema{Type: schema.TypeString}
+		}
+		elems := make([]hclwrite.Tokens, 0, len(items))
+		for _, item := range items {
+			if tokens, ok := exportTokens(elem, item, refs, selfID); ok {
+				elems = append(elems, tokens)
+			}
+		}
+		return hclwrite.TokensForTuple(elems), true
+	case schema.TypeMap:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		elem, _ := sch.Elem.(*schema.Schema)
+		if elem == nil {
+			elem = &schema.Schema{Type: schema.TypeString}
+		}
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		attrs := make([]hclwrite.ObjectAttrTokens, 0, len(m))
+		for _, k := range keys {
+			tokens, ok := exportTokens(elem, m[k], refs, selfID)
+			if !ok {
+				continue
+			}
+			name := hclwrite.TokensForValue(cty.StringVal(k))
+			if hclsyntax.ValidIdentifier(k) {
+				name = hclwrite.TokensForIdentifier(k)
+			}
+			attrs = append(attrs, hclwrite.ObjectAttrTokens{Name: name, Value: tokens})
+		}
+		return hclwrite.TokensForObject(attrs), true
+	}
+
+	return nil, false
+}
+
+// exportLabel derives a unique Terraform resource name from the name of a
+// FusionAuth object, i.e. "Default Theme" becomes "default_theme".
+func exportLabel(resourceType, name string, used map[string]bool) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			b.WriteRune(r)
+		case b.Len() > 0 && !strings.HasSuffix(b.String(), "_"):
+			b.WriteRune('_')
+		}
+	}
+
+	label := strings.TrimSuffix(b.String(), "_")
+	if label == "" || unicode.IsDigit(rune(label[0])) {
+		label = "r_" + label
+	}
+
+	unique := label
+	for i := 2; used[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	used[resourceType+"."+unique] = true
+
+	return unique
+}
+
+func checkExportResponse(what string, statusCode int, err error) error {
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %w", what, err)
+	}
+	if statusCode != http.StatusOK {
+		return fmt.Errorf("retrieving %s: %w", what, checkResponse(statusCode, nil))
+	}
+
+	return nil
+}
+
+func listTenantsForExport(ctx context.Context, client Client) ([]exportedObject, error) {
+	resp, err := client.FAClient.RetrieveTenantsWithContext(ctx)
+	if err := checkExportResponse("tenants", resp.StatusCode, err); err != nil {
+		return nil, err
+	}
+
+	objects := make([]exportedObject, 0, len(resp.Tenants))
+	for _, t := range resp.Tenants {
+		objects = append(objects, exportedObject{ResourceType: "fusionauth_tenant", Name: t.Name, ID: t.Id})
+	}
+
+	return objects, nil
+}
+
+func listApplicationsForExport(ctx context.Context, client Client) ([]exportedObject, error) {
+	resp, err := client.FAClient.RetrieveApplicationsWithContext(ctx)
+	if err := checkExportResponse("applications", resp.StatusCode, err); err != nil {
+		return nil, err
+	}
+
+	objects := make([]exportedObject, 0, len(resp.Applications))
+	for _, a := range resp.Applications {
+		objects = append(objects, exportedObject{ResourceType: "fusionauth_application", Name: a.Name, ID: a.Id})
+		for _, role := range a.Roles {
+			objects = append(objects, exportedObject{
+				ResourceType: "fusionauth_application_role",
+				Name:         a.Name + " " + role.Name,
+				ID:           role.Id,
+				ImportID:     a.Id + ":" + role.Id,
+				Attributes:   map[string]interface{}{"application_id": a.Id},
+			})
+		}
+	}
+
+	return objects, nil
+}
+
+func listKeysForExport(ctx context.Context, client Client) ([]exportedObject, error) {
+	resp, err := client.FAClient.RetrieveKeysWithContext(ctx)
+	if err := checkExportResponse("keys", resp.StatusCode, err); err != nil {
+		return nil, err
+	}
+
+	objects := make([]exportedObject, 0, len(resp.Keys))
+	for _, k := range resp.Keys {
+		objects = append(objects, exportedObject{ResourceType: keyResourceType(k), Name: k.Name, ID: k.Id})
+	}
+
+	return objects, nil
+}
+
+// keyResourceType returns the resource managing the key. FusionAuth does not
+// record whether a key was generated or imported, but a generated RSA or EC key
+// always has a private key and a self-signed certificate. HMAC keys cannot be
+// told apart and are exported as generated keys.
+func keyResourceType(k fusionauth.Key) string {
+	if k.Type == fusionauth.KeyType_HMAC {
+		return "fusionauth_key"
+	}
+	if !k.HasPrivateKey || k.Certificate == "" || k.CertificateInformation.Issuer != k.CertificateInformation.Subject {
+		return "fusionauth_imported_key"
+	}
+
+	return "fusionauth_key"
+}
+
+func listThemesForExport(ctx context.Context, client Client) ([]exportedObject, error) {
+	resp, err := client.FAClient.RetrieveThemesWithContext(ctx)
+	if err := checkExportResponse("themes", resp.StatusCode, err); err != nil {
+		return nil, err
+	}
+
+	objects := make([]exportedObject, 0, len(resp.Themes))
+	for _, t := range resp.Themes {
+		objects = append(objects, exportedObject{ResourceType: "fusionauth_theme", Name: t.Name, ID: t.Id})
+	}
+
+	return objects, nil
+}
+
+func listEmailTemplatesForExport(ctx context.Context, client Client) ([]exportedObject, error) {
+	resp, err := client.FAClient.RetrieveEmailTemplatesWithContext(ctx)
+	if err := checkExportResponse("email templates", resp.StatusCode, err); err != nil {
+		return nil, err
+	}
+
+	objects := make([]exportedObject, 0, len(resp.EmailTemplates))
+	for _, e := range resp.EmailTemplates {
+		objects = append(objects, exportedObject{ResourceType: "fusionauth_email", Name: e.Name, ID: e.Id})
+	}
+
+	return objects, nil
+}
+
+func listLambdasForExport(ctx context.Context, client Client) ([]exportedObject, error) {
+	resp, err := client.FAClient.RetrieveLambdasWithContext(ctx)
+	if err := checkExportResponse("lambdas", resp.StatusCode, err); err != nil {
+		return nil, err
+	}
+
+	objects := make([]exportedObject, 0, len(resp.Lambdas))
+	for _, l := range resp.Lambdas {
+		objects = append(objects, exportedObject{ResourceType: "fusionauth_lambda", Name: l.Name, ID: l.Id})
+	}
+
+	return objects, nil
+}
+
+func listWebhooksForExport(ctx context.Context, client Client) ([]exportedObject, error) {
+	resp, err := client.FAClient.RetrieveWebhooksWithContext(ctx)
+	if err := checkExportResponse("webhooks", resp.StatusCode, err); err != nil {
+		return nil, err
+	}
+
+	objects := make([]exportedObject, 0, len(resp.Webhooks))
+	for _, w := range resp.Webhooks {
+		name := w.Description
+		if name == "" {
+			name = w.Url
+		}
+		objects = append(objects, exportedObject{ResourceType: "fusionauth_webhook", Name: name, ID: w.Id})
+	}
+
+	return objects, nil
+}
+
+// identityProviderResourceTypes maps the FusionAuth identity provider types to
+// the resources managing them.
+func identityProviderResourceTypes() map[string]string {
+	return map[string]string{
+		"Apple":              "fusionauth_idp_apple",
+		"EpicGames":          "fusionauth_idp_epic_games",
+		"ExternalJWT":        "fusionauth_idp_external_jwt",
+		"Facebook":           "fusionauth_idp_facebook",
+		"Google":             "fusionauth_idp_google",
+		"HYPR":               "fusionauth_idp_hypr",
+		"LinkedIn":           "fusionauth_idp_linkedin",
+		"Nintendo":           "fusionauth_idp_nintendo",
+		"OpenIDConnect":      "fusionauth_idp_open_id_connect",
+		"SAMLv2":             "fusionauth_idp_saml_v2",
+		"SAMLv2IdPInitiated": "fusionauth_idp_saml_v2_idp_initated",
+		"SonyPSN":            "fusionauth_idp_sony_psn",
+		"Steam":              "fusionauth_idp_steam",
+		"Twitch":             "fusionauth_idp_twitch",
+		"Twitter":            "fusionauth_idp_twitter",
+		"Xbox":               "fusionauth_idp_xbox",
+	}
+}
+
+func listIdentityProvidersForExport(ctx context.Context, client Client) ([]exportedObject, error) {
+	resp, _, err := retrieveIdentityProviders(ctx, client)
+	if err := checkExportResponse("identity providers", resp.StatusCode, err); err != nil {
+		return nil, err
+	}
+
+	objects := make([]exportedObject, 0, len(resp.IdentityProviders))
+	for _, idp := range resp.IdentityProviders {
+		resourceType, ok := identityProviderResourceTypes()[string(idp.Type)]
+		if !ok {
+			continue
+		}
+
+		name := idp.Name
+		if name == "" {
+			name = string(idp.Type)
+		}
+		objects = append(objects, exportedObject{ResourceType: resourceType, Name: name, ID: idp.Id})
+	}
+
+	return objects, nil
+}
+
+func listFormFieldsForExport(ctx context.Context, client Client) ([]exportedObject, error) {
+	resp, err := client.FAClient.RetrieveFormFieldsWithContext(ctx)
+	if err := checkExportResponse("form fields", resp.StatusCode, err); err != nil {
+		return nil, err
+	}
+
+	objects := make([]exportedObject, 0, len(resp.Fields))
+	for _, f := range resp.Fields {
+		objects = append(objects, exportedObject{ResourceType: "fusionauth_form_field", Name: f.Name, ID: f.Id})
+	}
+
+	return objects, nil
+}
+
+func listFormsForExport(ctx context.Context, client Client) ([]exportedObject, error) {
+	resp, err := client.FAClient.RetrieveFormsWithContext(ctx)
+	if err := checkExportResponse("forms", resp.StatusCode, err); err != nil {
+		return nil, err
+	}
+
+	objects := make([]exportedObject, 0, len(resp.Forms))
+	for _, f := range resp.Forms {
+		objects = append(objects, exportedObject{ResourceType: "fusionauth_form", Name: f.Name, ID: f.Id})
+	}
+
+	return objects, nil
+}
+
+func listGroupsForExport(ctx context.Context, client Client) ([]exportedObject, error) {
+	resp, err := client.FAClient.RetrieveGroupsWithContext(ctx)
+	if err := checkExportResponse("groups", resp.StatusCode, err); err != nil {
+		return nil, err
+	}
+
+	objects := make([]exportedObject, 0, len(resp.Groups))
+	for _, g := range resp.Groups {
+		objects = append(objects, exportedObject{ResourceType: "fusionauth_group", Name: g.Name, ID: g.Id})
+	}
+
+	return objects, nil
+}
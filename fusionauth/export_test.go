@@ -0,0 +1,92 @@
+package fusionauth
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+func Test_Export(t *testing.T) {
+	fake := newFakeFusionAuth()
+	defer fake.Close()
+
+	tenantID := fake.create("tenant", map[string]interface{}{"name": "Default", "issuer": "acme.com"})
+	appID := fake.create("application", map[string]interface{}{
+		"name":     "My App",
+		"tenantId": tenantID,
+		"roles":    []interface{}{map[string]interface{}{"id": "9f1c4d7e-0b0a-4c57-a3a4-0c5e2b1e8a11", "name": "admin"}},
+	})
+	fake.create("group", map[string]interface{}{"name": "Admins", "tenantId": tenantID})
+	fake.create("key", map[string]interface{}{
+		"algorithm":              "RS256",
+		"certificate":            "-----BEGIN CERTIFICATE-----",
+		"certificateInformation": map[string]interface{}{"issuer": "CN=acme.com", "subject": "CN=acme.com"},
+		"hasPrivateKey":          true,
+		"name":                   "Generated",
+		"type":                   "RSA",
+	})
+	fake.create("key", map[string]interface{}{
+		"algorithm":     "RS256",
+		"hasPrivateKey": false,
+		"name":          "Partner",
+		"publicKey":     "-----BEGIN PUBLIC KEY-----",
+		"type":          "RSA",
+	})
+
+	var out bytes.Buffer
+	diags := Export(context.Background(), &out, map[string]interface{}{
+		"host":    fake.URL,
+		"api_key": fakeFusionAuthAPIKey,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+
+	if _, parseDiags := hclsyntax.ParseConfig(out.Bytes(), "export.tf", hcl.InitialPos); parseDiags.HasErrors() {
+		t.Fatalf("invalid configuration: %s\n%s", parseDiags.Error(), out.String())
+	}
+
+	// Ignore the alignment of attributes.
+	normalized := strings.Join(strings.Fields(out.String()), " ")
+	for _, want := range []string{
+		`to = fusionauth_tenant.default`,
+		`id = "` + tenantID + `"`,
+		`resource "fusionauth_tenant" "default" {`,
+		`issuer = "acme.com"`,
+		`resource "fusionauth_application" "my_app" {`,
+		`to = fusionauth_application_role.my_app_admin`,
+		`id = "` + appID + `:9f1c4d7e-0b0a-4c57-a3a4-0c5e2b1e8a11"`,
+		`resource "fusionauth_application_role" "my_app_admin" { application_id = fusionauth_application.my_app.id`,
+		`resource "fusionauth_group" "admins" {`,
+		`tenant_id = fusionauth_tenant.default.id`,
+		`resource "fusionauth_key" "generated" {`,
+		`resource "fusionauth_imported_key" "partner" {`,
+	} {
+		if !strings.Contains(normalized, want) {
+			t.Errorf("output does not contain %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func Test_exportLabel(t *testing.T) {
+	used := map[string]bool{}
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Default Theme", want: "default_theme"},
+		{name: "Default Theme", want: "default_theme_2"},
+		{name: "  FusionAuth (Reset) ", want: "fusionauth_reset"},
+		{name: "2FA", want: "r_2fa"},
+		{name: "", want: "r_"},
+	}
+	for _, tt := range tests {
+		if got := exportLabel("fusionauth_theme", tt.name, used); got != tt.want {
+			t.Errorf("exportLabel(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,1225 @@
+package fusionauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	fakeFusionAuthAPIKey  = "fake-api-key"
+	fakeFusionAuthVersion = "1.50.1"
+)
+
+// fakeRoute maps a FusionAuth API path to the objects it manages. Path
+// segments in braces are placeholders: {id} is the ID of the object, which is
+// optional when it is the last segment, and {pid} the ID of the parent object
+// of a sub-resource, such as the application of a role.
+type fakeRoute struct {
+	pattern string
+	// collection is the name under which the objects are stored, several
+	// routes may share one, i.e. creating and generating keys.
+	collection string
+	// property is the JSON property holding the object in request and
+	// response bodies.
+	property string
+	// list is the JSON property holding all objects when retrieving the
+	// collection without an ID.
+	list string
+	// parent and field are set for sub-resources that FusionAuth stores as
+	// part of their parent, such as application roles.
+	parent string
+	field  string
+	// idField is the property identifying a sub-resource, "id" if empty.
+	idField string
+	// tenantScoped is set for objects that belong to the tenant of the
+	// X-FusionAuth-TenantId header they are created with.
+	tenantScoped bool
+}
+
+// fakeRoutes returns the API paths served by the fake, most specific first.
+func fakeRoutes() []fakeRoute {
+	return []fakeRoute{
+		{pattern: "application/{pid}/role/{id}", parent: "application", property: "role", field: "roles"},
+		{pattern: "application/{pid}/scope/{id}", parent: "application", property: "scope", field: "scopes"},
+		{pattern: "entity/type/{pid}/permission/{id}", parent: "entity-type", property: "permission", field: "permissions"},
+		{pattern: "user/registration/{pid}/{id}", parent: "user", property: "registration", field: "registrations", idField: "applicationId"},
+		{pattern: "email/template/{id}", collection: "email-template", property: "emailTemplate", list: "emailTemplates"},
+		{pattern: "entity/type/{id}", collection: "entity-type", property: "entityType", list: "entityTypes"},
+		{pattern: "form/field/{id}", collection: "form-field", property: "field", list: "fields"},
+		{pattern: "key/generate/{id}", collection: "key", property: "key", list: "keys"},
+		{pattern: "key/import/{id}", collection: "key", property: "key", list: "keys"},
+		{pattern: "message/template/{id}", collection: "message-template", property: "messageTemplate", list: "messageTemplates"},
+		{pattern: "user/consent/{id}", collection: "user-consent", property: "userConsent", list: "userConsents"},
+		{pattern: "api-key/{id}", collection: "api-key", property: "apiKey", list: "apiKeys"},
+		{pattern: "application/{id}", collection: "application", property: "application", list: "applications", tenantScoped: true},
+		{pattern: "connector/{id}", collection: "connector", property: "connector", list: "connectors"},
+		{pattern: "consent/{id}", collection: "consent", property: "consent", list: "consents"},
+		{pattern: "entity/{id}", collection: "entity", property: "entity", list: "entities", tenantScoped: true},
+		{pattern: "form/{id}", collection: "form", property: "form", list: "forms"},
+		{pattern: "group/{id}", collection: "group", property: "group", list: "groups", tenantScoped: true},
+		{pattern: "identity-provider/{id}", collection: "identity-provider", property: "identityProvider", list: "identityProviders"},
+		{pattern: "ip-acl/{id}", collection: "ip-acl", property: "ipAccessControlList", list: "ipAccessControlLists"},
+		{pattern: "key/{id}", collection: "key", property: "key", list: "keys"},
+		{pattern: "lambda/{id}", collection: "lambda", property: "lambda", list: "lambdas"},
+		{pattern: "messenger/{id}", collection: "messenger", property: "messenger", list: "messengers"},
+		{pattern: "tenant/{id}", collection: "tenant", property: "tenant", list: "tenants"},
+		{pattern: "theme/{id}", collection: "theme", property: "theme", list: "themes"},
+		{pattern: "user/{id}", collection: "user", property: "user", list: "users", tenantScoped: true},
+		{pattern: "user-action/{id}", collection: "user-action", property: "userAction", list: "userActions"},
+		{pattern: "user-action-reason/{id}", collection: "user-action-reason", property: "userActionReason", list: "userActionReasons"},
+		{pattern: "webhook/{id}", collection: "webhook", property: "webhook", list: "webhooks"},
+	}
+}
+
+// fakeFusionAuth is an in-memory stand-in for the FusionAuth API, so the
+// acceptance tests can run without a FusionAuth instance. It implements the
+// generic create, retrieve, update, patch and delete semantics shared by the
+// FusionAuth APIs but none of their validation or business logic.
+type fakeFusionAuth struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	objects map[string]map[string]map[string]interface{}
+	order   map[string][]string
+	sysCfg  map[string]interface{}
+	integ   map[string]interface{}
+	reactor map[string]interface{}
+	grants  map[string][]map[string]interface{}
+	members map[string][]map[string]interface{}
+	links   []map[string]interface{}
+}
+
+// newFakeFusionAuth starts a fake FusionAuth server. It must be closed when
+// no longer needed.
+func newFakeFusionAuth() *fakeFusionAuth {
+	f := &fakeFusionAuth{
+		objects: make(map[string]map[string]map[string]interface{}),
+		order:   make(map[string][]string),
+		sysCfg:  make(map[string]interface{}),
+		integ:   make(map[string]interface{}),
+		grants:  make(map[string][]map[string]interface{}),
+		members: make(map[string][]map[string]interface{}),
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+
+	return f
+}
+
+// newFakeClient starts a fake FusionAuth server for the duration of the test
+// and returns it together with a client connected to it.
+func newFakeClient(t *testing.T) (*fakeFusionAuth, Client) {
+	t.Helper()
+
+	fake := newFakeFusionAuth()
+	t.Cleanup(fake.Close)
+
+	u, _ := url.Parse(fake.URL)
+	return fake, Client{FAClient: *fusionauth.NewClient(fake.Client(), u, fakeFusionAuthAPIKey)}
+}
+
+// planDiff returns the changes Terraform would plan for config against the
+// state of data, so tests can check that a resource does not drift once it
+// has been applied. A nil diff means an empty plan.
+func planDiff(t *testing.T, r *schema.Resource, data *schema.ResourceData, config map[string]interface{}, client Client) *terraform.InstanceDiff {
+	t.Helper()
+
+	diff, err := r.Diff(context.Background(), data.State(), terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+	if diff != nil && diff.Empty() {
+		return nil
+	}
+
+	return diff
+}
+
+// applyConfig creates a resource from config the way terraform apply does,
+// planning it first so that schema defaults apply, and returns the state read
+// back from the server.
+func applyConfig(t *testing.T, r *schema.Resource, config map[string]interface{}, client Client) *schema.ResourceData {
+	t.Helper()
+
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+	state, diags := r.Apply(context.Background(), nil, diff, client)
+	if diags.HasError() {
+		t.Fatalf("apply: %#v", diags)
+	}
+
+	return refreshState(t, r, state, client)
+}
+
+// refreshState reads the resource of state from the server.
+func refreshState(t *testing.T, r *schema.Resource, state *terraform.InstanceState, client Client) *schema.ResourceData {
+	t.Helper()
+
+	state, diags := r.RefreshWithoutUpgrade(context.Background(), state, client)
+	if diags.HasError() {
+		t.Fatalf("refresh: %#v", diags)
+	}
+	if state == nil {
+		t.Fatal("refresh: the resource no longer exists")
+	}
+
+	return r.Data(state)
+}
+
+// importStateVerify imports the resource of data by importID the way
+// terraform import does, and fails the test if the attributes read back
+// differ from those of data, apart from the ignored attributes and their
+// nested attributes.
+func importStateVerify(t *testing.T, r *schema.Resource, data *schema.ResourceData, importID string, client Client, ignore ...string) {
+	t.Helper()
+
+	imported := r.Data(nil)
+	imported.SetId(importID)
+	if r.Importer != nil && r.Importer.StateContext != nil {
+		results, err := r.Importer.StateContext(context.Background(), imported, client)
+		if err != nil {
+			t.Fatalf("import: %v", err)
+		}
+		if len(results) != 1 {
+			t.Fatalf("import: expected one resource, got %d", len(results))
+		}
+		imported = results[0]
+	}
+
+	want := data.State().Attributes
+	got := refreshState(t, r, imported.State(), client).State().Attributes
+	for _, attrs := range []map[string]string{want, got} {
+		for k := range attrs {
+			for _, i := range ignore {
+				if k == i || strings.HasPrefix(k, i+".") {
+					delete(attrs, k)
+				}
+			}
+		}
+	}
+
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("imported %s = %q, want %q", k, got[k], v)
+		}
+	}
+	for k, v := range got {
+		if _, ok := want[k]; !ok {
+			t.Errorf("imported %s = %q, want it to be unset", k, v)
+		}
+	}
+}
+
+func (f *fakeFusionAuth) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api"), "/")
+
+	if path == "status" {
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{})
+		return
+	}
+	if r.Header.Get("Authorization") != fakeFusionAuthAPIKey {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var body map[string]interface{}
+	if r.Body != nil {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch path {
+	case "system/version":
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{"version": fakeFusionAuthVersion})
+		return
+	case "system-configuration":
+		if r.Method == http.MethodPut || r.Method == http.MethodPatch {
+			if cfg, ok := body["systemConfiguration"].(map[string]interface{}); ok {
+				f.sysCfg = cfg
+			}
+		}
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{"systemConfiguration": f.sysCfg})
+		return
+	case "integration":
+		if r.Method == http.MethodPut || r.Method == http.MethodPatch {
+			if integrations, ok := body["integrations"].(map[string]interface{}); ok {
+				f.integ = integrations
+			}
+		}
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{"integrations": f.integ})
+		return
+	case "reactor":
+		f.serveReactor(w, r, body)
+		return
+	case "identity-provider/link":
+		f.serveIdentityProviderLink(w, r, body)
+		return
+	case "group/member":
+		f.serveGroupMembers(w, r, body)
+		return
+	case "group/member/search":
+		f.searchGroupMembers(w, r, body)
+		return
+	}
+
+	if path == "user/family" || strings.HasPrefix(path, "user/family/") {
+		f.serveFamily(w, r, strings.TrimPrefix(strings.TrimPrefix(path, "user/family"), "/"), body)
+		return
+	}
+
+	if pid, _, ok := matchFakeRoute("entity/{pid}/grant", path); ok && pid != "" {
+		f.serveEntityGrant(w, r, pid, body)
+		return
+	}
+
+	for _, route := range fakeRoutes() {
+		pid, id, ok := matchFakeRoute(route.pattern, path)
+		if !ok {
+			continue
+		}
+
+		if route.parent != "" {
+			f.serveSubResource(w, r, route, pid, id, body)
+		} else {
+			f.serveCollection(w, r, route, id, body)
+		}
+		return
+	}
+
+	writeFakeError(w, http.StatusNotImplemented, fmt.Sprintf("%s /api/%s is not supported by the fake FusionAuth server", r.Method, path))
+}
+
+func (f *fakeFusionAuth) serveCollection(w http.ResponseWriter, r *http.Request, route fakeRoute, id string, body map[string]interface{}) {
+	objects := f.collection(route.collection)
+
+	if obj, ok := objects[id]; ok && r.Method != http.MethodPost && !visibleInTenant(obj, r) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if id == "" {
+			f.serveList(w, r, route)
+			return
+		}
+		obj, ok := objects[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{route.property: obj})
+
+	case http.MethodPost:
+		obj := fakeObject(body, route.property)
+		if id == "" {
+			id, _ = obj["id"].(string)
+		}
+		if id == "" {
+			id, _ = uuid.GenerateUUID()
+		}
+		if _, ok := objects[id]; ok {
+			writeFakeFieldError(w, route.property+".id", "[duplicate]", "An object with Id ["+id+"] already exists.")
+			return
+		}
+
+		if tid := r.Header.Get("X-FusionAuth-TenantId"); route.tenantScoped && tid != "" && obj["tenantId"] == nil {
+			obj["tenantId"] = tid
+		}
+
+		now := time.Now().UnixMilli()
+		obj["id"] = id
+		obj["insertInstant"] = now
+		obj["lastUpdateInstant"] = now
+		objects[id] = obj
+		f.order[route.collection] = append(f.order[route.collection], id)
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{route.property: obj})
+
+	case http.MethodPut, http.MethodPatch:
+		existing, ok := objects[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		obj := fakeObject(body, route.property)
+		if r.Method == http.MethodPatch {
+			for k, v := range obj {
+				existing[k] = v
+			}
+			obj = existing
+		}
+		if route.tenantScoped && obj["tenantId"] == nil {
+			obj["tenantId"] = existing["tenantId"]
+		}
+		obj["id"] = id
+		obj["insertInstant"] = existing["insertInstant"]
+		obj["lastUpdateInstant"] = time.Now().UnixMilli()
+		objects[id] = obj
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{route.property: obj})
+
+	case http.MethodDelete:
+		if _, ok := objects[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(objects, id)
+		f.order[route.collection] = removeFakeID(f.order[route.collection], id)
+		w.WriteHeader(http.StatusOK)
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// serveList returns all objects of a collection. Query parameters, such as
+// ?username=, select a single object by the value of a property instead.
+func (f *fakeFusionAuth) serveList(w http.ResponseWriter, r *http.Request, route fakeRoute) {
+	objects := f.collection(route.collection)
+
+	query := r.URL.Query()
+	if len(query) > 0 {
+		for _, id := range f.order[route.collection] {
+			obj := objects[id]
+			matches := true
+			for k := range query {
+				if v, _ := obj[k].(string); v != query.Get(k) {
+					matches = false
+				}
+			}
+			if matches {
+				writeFakeJSON(w, http.StatusOK, map[string]interface{}{route.property: obj})
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	list := make([]interface{}, 0, len(objects))
+	for _, id := range f.order[route.collection] {
+		list = append(list, objects[id])
+	}
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{route.list: list})
+}
+
+func (f *fakeFusionAuth) serveSubResource(w http.ResponseWriter, r *http.Request, route fakeRoute, pid, id string, body map[string]interface{}) {
+	parent, ok := f.collection(route.parent)[pid]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	idField := route.idField
+	if idField == "" {
+		idField = "id"
+	}
+
+	items, _ := parent[route.field].([]interface{})
+	index := -1
+	for i, item := range items {
+		if m, ok := item.(map[string]interface{}); ok && id != "" && m[idField] == id {
+			index = i
+		}
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if index < 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{route.property: items[index]})
+
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		obj := fakeObject(body, route.property)
+		if id == "" {
+			id, _ = obj[idField].(string)
+			for i, item := range items {
+				if m, ok := item.(map[string]interface{}); ok && id != "" && m[idField] == id {
+					index = i
+				}
+			}
+		}
+		if id == "" {
+			id, _ = uuid.GenerateUUID()
+		}
+		obj[idField] = id
+
+		switch {
+		case r.Method == http.MethodPost && index >= 0:
+			writeFakeFieldError(w, route.property+"."+idField, "[duplicate]", "An object with Id ["+id+"] already exists.")
+			return
+		case r.Method != http.MethodPost && index < 0:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		case index >= 0:
+			if _, ok := obj["id"]; !ok {
+				obj["id"] = items[index].(map[string]interface{})["id"]
+			}
+			items[index] = obj
+		default:
+			if _, ok := obj["id"]; !ok {
+				obj["id"], _ = uuid.GenerateUUID()
+			}
+			items = append(items, obj)
+		}
+		parent[route.field] = items
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{route.property: obj, route.parent: parent})
+
+	case http.MethodDelete:
+		if index < 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		parent[route.field] = append(items[:index], items[index+1:]...)
+		w.WriteHeader(http.StatusOK)
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// serveReactor activates, deactivates and reports the status of Reactor. The
+// license itself is never returned, as with FusionAuth.
+func (f *fakeFusionAuth) serveReactor(w http.ResponseWriter, r *http.Request, body map[string]interface{}) {
+	switch r.Method {
+	case http.MethodPost:
+		f.reactor = body
+	case http.MethodDelete:
+		f.reactor = nil
+	}
+
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{
+		"status": map[string]interface{}{"licensed": f.reactor != nil},
+	})
+}
+
+// serveEntityGrant upserts, retrieves and deletes the grants of an entity,
+// which are identified by the userId or recipientEntityId query parameter.
+func (f *fakeFusionAuth) serveEntityGrant(w http.ResponseWriter, r *http.Request, entityID string, body map[string]interface{}) {
+	entity, ok := f.collection("entity")[entityID]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if !visibleInTenant(entity, r) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	matches := func(grant map[string]interface{}, userID, recipientEntityID string) bool {
+		if userID != "" {
+			return grant["userId"] == userID
+		}
+		if e, ok := grant["recipientEntity"].(map[string]interface{}); ok {
+			return e["id"] == recipientEntityID
+		}
+		return grant["recipientEntityId"] == recipientEntityID
+	}
+
+	grants := f.grants[entityID]
+	query := r.URL.Query()
+
+	switch r.Method {
+	case http.MethodPost:
+		grant := fakeObject(body, "grant")
+		userID, _ := grant["userId"].(string)
+		recipientEntityID, _ := grant["recipientEntityId"].(string)
+		for i, g := range grants {
+			if matches(g, userID, recipientEntityID) {
+				grants = append(grants[:i], grants[i+1:]...)
+				break
+			}
+		}
+		if id, _ := grant["id"].(string); id == "" {
+			grant["id"], _ = uuid.GenerateUUID()
+		}
+		f.grants[entityID] = append(grants, grant)
+		w.WriteHeader(http.StatusOK)
+
+	case http.MethodGet, http.MethodDelete:
+		for i, g := range grants {
+			if !matches(g, query.Get("userId"), query.Get("recipientEntityId")) {
+				continue
+			}
+			if r.Method == http.MethodDelete {
+				f.grants[entityID] = append(grants[:i], grants[i+1:]...)
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			grant := make(map[string]interface{}, len(g)+1)
+			for k, v := range g {
+				grant[k] = v
+			}
+			grant["entity"] = entity
+			writeFakeJSON(w, http.StatusOK, map[string]interface{}{"grant": grant})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// serveGroupMembers adds (POST), replaces (PUT) and removes (DELETE) the
+// members of groups, which are keyed by group ID in the request.
+func (f *fakeFusionAuth) serveGroupMembers(w http.ResponseWriter, r *http.Request, body map[string]interface{}) {
+	requested, _ := body["members"].(map[string]interface{})
+
+	for groupID, m := range requested {
+		if group, ok := f.collection("group")[groupID]; !ok || !visibleInTenant(group, r) {
+			writeFakeFieldError(w, "members", "[invalid]members", "The group ["+groupID+"] does not exist.")
+			return
+		}
+
+		list, _ := m.([]interface{})
+		switch r.Method {
+		case http.MethodPost, http.MethodPut:
+			if r.Method == http.MethodPut {
+				f.members[groupID] = nil
+			}
+			for _, v := range list {
+				member, _ := v.(map[string]interface{})
+				f.removeGroupMember(groupID, member["userId"])
+				member["id"], _ = uuid.GenerateUUID()
+				member["groupId"] = groupID
+				f.members[groupID] = append(f.members[groupID], member)
+			}
+
+		case http.MethodDelete:
+			for _, userID := range list {
+				f.removeGroupMember(groupID, userID)
+			}
+
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+	}
+
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{"members": f.members})
+}
+
+func (f *fakeFusionAuth) removeGroupMember(groupID string, userID interface{}) {
+	members := f.members[groupID]
+	for i, m := range members {
+		if m["userId"] == userID {
+			f.members[groupID] = append(members[:i:i], members[i+1:]...)
+			return
+		}
+	}
+}
+
+// serveIdentityProviderLink serves /api/identity-provider/link, where links
+// are identified by query parameters.
+func (f *fakeFusionAuth) serveIdentityProviderLink(w http.ResponseWriter, r *http.Request, body map[string]interface{}) {
+	q := r.URL.Query()
+	find := func() int {
+		for i, l := range f.links {
+			if l["identityProviderId"] == q.Get("identityProviderId") &&
+				l["identityProviderUserId"] == q.Get("identityProviderUserId") &&
+				l["userId"] == q.Get("userId") {
+				return i
+			}
+		}
+		return -1
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		link, _ := body["identityProviderLink"].(map[string]interface{})
+		f.links = append(f.links, link)
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{"identityProviderLink": link})
+	case http.MethodGet:
+		i := find()
+		if i < 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{"identityProviderLink": f.links[i]})
+	case http.MethodDelete:
+		i := find()
+		if i < 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		link := f.links[i]
+		f.links = append(f.links[:i:i], f.links[i+1:]...)
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{"identityProviderLink": link})
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// serveFamily serves /api/user/family/{familyId} and
+// /api/user/family/{familyId}/{userId}. A family is deleted with its last
+// member.
+func (f *fakeFusionAuth) serveFamily(w http.ResponseWriter, r *http.Request, path string, body map[string]interface{}) {
+	familyID, userID, _ := strings.Cut(path, "/")
+	families := f.collection("family")
+	family, exists := families[familyID]
+
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{"family": family})
+		return
+
+	case http.MethodPost:
+		if exists {
+			writeFakeFieldError(w, "familyId", "[duplicate]familyId", "A family with Id ["+familyID+"] already exists.")
+			return
+		}
+		if familyID == "" {
+			familyID, _ = uuid.GenerateUUID()
+		}
+		family = map[string]interface{}{"id": familyID}
+		families[familyID] = family
+		f.order["family"] = append(f.order["family"], familyID)
+
+	case http.MethodPut:
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+	case http.MethodDelete:
+		members, _ := family["members"].([]interface{})
+		for i, m := range members {
+			if m.(map[string]interface{})["userId"] == userID {
+				family["members"] = append(members[:i:i], members[i+1:]...)
+				if len(members) == 1 {
+					delete(families, familyID)
+					f.order["family"] = removeFakeID(f.order["family"], familyID)
+				}
+				writeFakeJSON(w, http.StatusOK, map[string]interface{}{})
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		return
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	member, _ := body["familyMember"].(map[string]interface{})
+	members, _ := family["members"].([]interface{})
+	family["members"] = append(members, member)
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{"family": family})
+}
+
+// searchGroupMembers pages through the members of a group, optionally limited
+// to a single user.
+func (f *fakeFusionAuth) searchGroupMembers(w http.ResponseWriter, r *http.Request, body map[string]interface{}) {
+	search, _ := body["search"].(map[string]interface{})
+	groupID, _ := search["groupId"].(string)
+	userID, _ := search["userId"].(string)
+	startRow, _ := search["startRow"].(float64)
+	numberOfResults, ok := search["numberOfResults"].(float64)
+	if !ok {
+		numberOfResults = 25
+	}
+
+	matches := make([]map[string]interface{}, 0)
+	for _, m := range f.members[groupID] {
+		if !visibleInTenant(f.collection("group")[groupID], r) {
+			break
+		}
+		if userID == "" || m["userId"] == userID {
+			matches = append(matches, m)
+		}
+	}
+
+	start, end := int(startRow), int(startRow+numberOfResults)
+	if start > len(matches) {
+		start = len(matches)
+	}
+	if end > len(matches) {
+		end = len(matches)
+	}
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{"members": matches[start:end], "total": len(matches)})
+}
+
+// visibleInTenant reports whether obj can be seen by a request. As with
+// FusionAuth, objects of a tenant other than the one of the
+// X-FusionAuth-TenantId header are not found.
+func visibleInTenant(obj map[string]interface{}, r *http.Request) bool {
+	objTenantID, _ := obj["tenantId"].(string)
+	tid := r.Header.Get("X-FusionAuth-TenantId")
+
+	return tid == "" || objTenantID == "" || objTenantID == tid
+}
+
+// create stores an object in a collection of the fake, returning its ID.
+func (f *fakeFusionAuth) create(collection string, obj map[string]interface{}) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id, _ := uuid.GenerateUUID()
+	obj["id"] = id
+	f.collection(collection)[id] = obj
+	f.order[collection] = append(f.order[collection], id)
+
+	return id
+}
+
+func (f *fakeFusionAuth) collection(name string) map[string]map[string]interface{} {
+	if f.objects[name] == nil {
+		f.objects[name] = make(map[string]map[string]interface{})
+	}
+
+	return f.objects[name]
+}
+
+// matchFakeRoute matches a path below /api against a route pattern, returning
+// the values of its placeholders.
+func matchFakeRoute(pattern, path string) (pid, id string, ok bool) {
+	patternSegments := strings.Split(pattern, "/")
+	pathSegments := strings.Split(path, "/")
+	if path == "" {
+		pathSegments = nil
+	}
+
+	if len(pathSegments) != len(patternSegments) && len(pathSegments) != len(patternSegments)-1 {
+		return "", "", false
+	}
+	if len(pathSegments) < len(patternSegments) && patternSegments[len(patternSegments)-1] != "{id}" {
+		return "", "", false
+	}
+
+	for i, segment := range pathSegments {
+		switch patternSegments[i] {
+		case "{pid}":
+			pid = segment
+		case "{id}":
+			id = segment
+		default:
+			if segment != patternSegments[i] {
+				return "", "", false
+			}
+		}
+	}
+
+	return pid, id, true
+}
+
+// fakeObject returns a copy of the object held by the given property of a
+// request body.
+func fakeObject(body map[string]interface{}, property string) map[string]interface{} {
+	obj := make(map[string]interface{})
+	if m, ok := body[property].(map[string]interface{}); ok {
+		for k, v := range m {
+			obj[k] = v
+		}
+	}
+
+	return obj
+}
+
+func removeFakeID(ids []string, id string) []string {
+	out := ids[:0]
+	for _, i := range ids {
+		if i != id {
+			out = append(out, i)
+		}
+	}
+
+	return out
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeFakeError(w http.ResponseWriter, status int, message string) {
+	writeFakeJSON(w, status, map[string]interface{}{
+		"generalErrors": []map[string]string{{"code": "[fake]", "message": message}},
+	})
+}
+
+func writeFakeFieldError(w http.ResponseWriter, field, code, message string) {
+	writeFakeJSON(w, http.StatusBadRequest, map[string]interface{}{
+		"fieldErrors": map[string][]map[string]string{
+			field: {{"code": code, "message": message}},
+		},
+	})
+}
+
+// ids returns the IDs of all objects in a collection of the
+// fake, sorted.
+func (f *fakeFusionAuth) ids(collection string) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ids := append([]string(nil), f.order[collection]...)
+	sort.Strings(ids)
+	return ids
+}
+
+// Test_fakeFusionAuth_resources runs every resource through create, read,
+// update and delete against the fake server, using the zero value of each
+// attribute.
+func Test_fakeFusionAuth_resources(t *testing.T) {
+	fake := newFakeFusionAuth()
+	defer fake.Close()
+
+	p := Provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"host":    fake.URL,
+		"api_key": fakeFusionAuthAPIKey,
+	}))
+	if diags.HasError() {
+		t.Fatalf("error configuring provider: %#v", diags)
+	}
+	client := p.Meta().(Client)
+
+	// Sub-resources need their parent to exist.
+	parents := map[string]func(data *schema.ResourceData){
+		"fusionauth_application_oauth_scope": func(data *schema.ResourceData) {
+			_ = data.Set("application_id", fake.create("application", map[string]interface{}{}))
+			_ = data.Set("name", "read:documents")
+		},
+		"fusionauth_application_role": func(data *schema.ResourceData) {
+			_ = data.Set("application_id", fake.create("application", map[string]interface{}{}))
+		},
+		"fusionauth_entity_grant": func(data *schema.ResourceData) {
+			_ = data.Set("entity_id", fake.create("entity", map[string]interface{}{}))
+			_ = data.Set("user_id", fake.create("user", map[string]interface{}{}))
+		},
+		"fusionauth_entity_type_permission": func(data *schema.ResourceData) {
+			_ = data.Set("entity_type_id", fake.create("entity-type", map[string]interface{}{}))
+		},
+		"fusionauth_family": func(data *schema.ResourceData) {
+			_ = data.Set("member", []interface{}{map[string]interface{}{
+				"owner":   true,
+				"role":    "Adult",
+				"user_id": fake.create("user", map[string]interface{}{}),
+			}})
+		},
+		"fusionauth_group_membership": func(data *schema.ResourceData) {
+			_ = data.Set("group_id", fake.create("group", map[string]interface{}{}))
+			_ = data.Set("user_id", fake.create("user", map[string]interface{}{}))
+		},
+		"fusionauth_identity_provider_link": func(data *schema.ResourceData) {
+			_ = data.Set("identity_provider_id", fake.create("identity-provider", map[string]interface{}{"type": "Google"}))
+			_ = data.Set("identity_provider_user_id", "108273645")
+			_ = data.Set("user_id", fake.create("user", map[string]interface{}{}))
+		},
+		"fusionauth_registration": func(data *schema.ResourceData) {
+			_ = data.Set("user_id", fake.create("user", map[string]interface{}{}))
+			_ = data.Set("application_id", fake.create("application", map[string]interface{}{}))
+		},
+		"fusionauth_user_consent": func(data *schema.ResourceData) {
+			_ = data.Set("consent_id", fake.create("consent", map[string]interface{}{}))
+			_ = data.Set("user_id", fake.create("user", map[string]interface{}{}))
+		},
+	}
+
+	names := make([]string, 0, len(p.ResourcesMap))
+	for name := range p.ResourcesMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		r := p.ResourcesMap[name]
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			data := r.TestResourceData()
+			if setParent, ok := parents[name]; ok {
+				setParent(data)
+			}
+
+			if diags := r.CreateContext(ctx, data, client); diags.HasError() {
+				t.Fatalf("create: %#v", diags)
+			}
+			if data.Id() == "" {
+				t.Fatal("create did not set an ID")
+			}
+			if diags := r.ReadContext(ctx, data, client); diags.HasError() {
+				t.Fatalf("read: %#v", diags)
+			}
+			if r.UpdateContext != nil {
+				if diags := r.UpdateContext(ctx, data, client); diags.HasError() {
+					t.Fatalf("update: %#v", diags)
+				}
+			}
+			if diags := r.DeleteContext(ctx, data, client); diags.HasError() {
+				t.Fatalf("delete: %#v", diags)
+			}
+		})
+	}
+}
+
+// Test_fakeFusionAuth_roundTrip applies a configuration of each resource
+// against the fake server and checks that reading it back neither plans a
+// change nor differs from an import of the same object.
+func Test_fakeFusionAuth_roundTrip(t *testing.T) {
+	idpTenantConfiguration := []interface{}{map[string]interface{}{
+		"tenant_id":                           "c1a7e0f2-2e1d-4c4c-9d4c-6a1f3c0f9a55",
+		"limit_user_link_count_enabled":       true,
+		"limit_user_link_count_maximum_links": 2,
+	}}
+
+	tests := []struct {
+		name     string
+		resource *schema.Resource
+		config   func(fake *fakeFusionAuth) map[string]interface{}
+		importID func(data *schema.ResourceData) string
+		ignore   []string
+	}{
+		{
+			name:     "fusionauth_application_oauth_scope",
+			resource: newApplicationOAuthScope(),
+			config: func(fake *fakeFusionAuth) map[string]interface{} {
+				return map[string]interface{}{
+					"application_id":          fake.create("application", map[string]interface{}{"name": "App"}),
+					"data":                    map[string]interface{}{"owner": "orders"},
+					"default_consent_message": "Allow access to your orders",
+					"description":             "Read your orders",
+					"name":                    "orders:read",
+					"required":                true,
+				}
+			},
+			importID: func(data *schema.ResourceData) string {
+				return data.Get("application_id").(string) + ":" + data.Id()
+			},
+		},
+		{
+			name:     "fusionauth_consent",
+			resource: newConsent(),
+			config: func(*fakeFusionAuth) map[string]interface{} {
+				return map[string]interface{}{
+					"name":                                 "COPPA",
+					"default_minimum_age_for_self_consent": 13,
+					"country_minimum_age_for_self_consent": map[string]interface{}{"de": 16},
+					"email_plus": []interface{}{map[string]interface{}{
+						"enabled":           true,
+						"email_template_id": "7d7bff12-3e5d-4e8d-9cbb-1d9a1c1f5c0e",
+					}},
+					"values": []interface{}{"email", "sms"},
+				}
+			},
+		},
+		{
+			name:     "fusionauth_family",
+			resource: newFamily(),
+			config: func(fake *fakeFusionAuth) map[string]interface{} {
+				return map[string]interface{}{
+					"member": []interface{}{
+						map[string]interface{}{"role": "Child", "user_id": fake.create("user", map[string]interface{}{})},
+						map[string]interface{}{"owner": true, "role": "Adult", "user_id": fake.create("user", map[string]interface{}{})},
+					},
+				}
+			},
+		},
+		{
+			name:     "fusionauth_generic_messenger",
+			resource: newGenericMessenger(),
+			config: func(*fakeFusionAuth) map[string]interface{} {
+				return map[string]interface{}{
+					"connect_timeout":              1000,
+					"headers":                      map[string]interface{}{"X-Tenant": "acme"},
+					"http_authentication_password": "password",
+					"http_authentication_username": "fusionauth",
+					"name":                         "Generic",
+					"read_timeout":                 2000,
+					"url":                          "https://sms.example.com/send",
+				}
+			},
+		},
+		{
+			name:     "fusionauth_idp_epic_games",
+			resource: resourceIDPEpicGames(),
+			config: func(fake *fakeFusionAuth) map[string]interface{} {
+				return map[string]interface{}{
+					"button_text":   "Login with Epic Games",
+					"client_id":     "client-id",
+					"client_secret": "client-secret",
+					"scope":         "basic_profile",
+					"enabled":       true,
+					"application_configuration": []interface{}{map[string]interface{}{
+						"application_id": fake.create("application", map[string]interface{}{"name": "App"}),
+						"enabled":        true,
+						"scope":          "basic_profile friends_list",
+					}},
+					"tenant_configuration": idpTenantConfiguration,
+				}
+			},
+			ignore: []string{"idp_id"},
+		},
+		{
+			name:     "fusionauth_idp_hypr",
+			resource: resourceIDPHYPR(),
+			config: func(fake *fakeFusionAuth) map[string]interface{} {
+				return map[string]interface{}{
+					"relying_party_application_id": "relying-party",
+					"relying_party_url":            "https://hypr.example.com",
+					"enabled":                      true,
+					"application_configuration": []interface{}{map[string]interface{}{
+						"application_id":    fake.create("application", map[string]interface{}{"name": "App"}),
+						"enabled":           true,
+						"relying_party_url": "https://app.hypr.example.com",
+					}},
+					"tenant_configuration": idpTenantConfiguration,
+				}
+			},
+			ignore: []string{"idp_id"},
+		},
+		{
+			name:     "fusionauth_idp_nintendo",
+			resource: resourceIDPNintendo(),
+			config: func(fake *fakeFusionAuth) map[string]interface{} {
+				return map[string]interface{}{
+					"button_text":   "Login with Nintendo",
+					"client_id":     "client-id",
+					"client_secret": "client-secret",
+					"enabled":       true,
+					"application_configuration": []interface{}{map[string]interface{}{
+						"application_id": fake.create("application", map[string]interface{}{"name": "App"}),
+						"enabled":        true,
+						"button_text":    "Login with Nintendo at work",
+					}},
+					"tenant_configuration": idpTenantConfiguration,
+				}
+			},
+			ignore: []string{"idp_id"},
+		},
+		{
+			name:     "fusionauth_idp_twitter",
+			resource: resourceIDPTwitter(),
+			config: func(fake *fakeFusionAuth) map[string]interface{} {
+				return map[string]interface{}{
+					"button_text":     "Login with Twitter",
+					"consumer_key":    "consumer-key",
+					"consumer_secret": "consumer-secret",
+					"enabled":         true,
+					"application_configuration": []interface{}{map[string]interface{}{
+						"application_id": fake.create("application", map[string]interface{}{"name": "App"}),
+						"enabled":        true,
+						"button_text":    "Login with Twitter at work",
+					}},
+					"tenant_configuration": idpTenantConfiguration,
+				}
+			},
+			ignore: []string{"idp_id"},
+		},
+		{
+			name:     "fusionauth_integrations",
+			resource: resourceIntegrations(),
+			config: func(fake *fakeFusionAuth) map[string]interface{} {
+				applicationID := fake.create("application", map[string]interface{}{"name": "App"})
+				return map[string]interface{}{
+					"cleanspeak": []interface{}{map[string]interface{}{
+						"api_key":         "cleanspeak-api-key",
+						"application_ids": []interface{}{applicationID},
+						"enabled":         true,
+						"url":             "https://cleanspeak.example.com",
+						"username_moderation": []interface{}{map[string]interface{}{
+							"application_id": applicationID,
+							"enabled":        true,
+						}},
+					}},
+					"kafka": []interface{}{map[string]interface{}{
+						"enabled":  true,
+						"producer": map[string]interface{}{"bootstrap.servers": "kafka:9092"},
+					}},
+				}
+			},
+			// FusionAuth does not return the CleanSpeak API key.
+			ignore: []string{"cleanspeak.0.api_key"},
+		},
+		{
+			name:     "fusionauth_kafka_messenger",
+			resource: newKafkaMessenger(),
+			config: func(*fakeFusionAuth) map[string]interface{} {
+				return map[string]interface{}{
+					"default_topic": "sms",
+					"name":          "Kafka",
+					"producer": map[string]interface{}{
+						"bootstrap.servers": "kafka:9092",
+						"sasl.jaas.config":  `org.apache.kafka.common.security.plain.PlainLoginModule required username="fusionauth" password="secret";`,
+					},
+				}
+			},
+		},
+		{
+			name:     "fusionauth_twilio_messenger",
+			resource: newTwilioMessenger(),
+			config: func(*fakeFusionAuth) map[string]interface{} {
+				return map[string]interface{}{
+					"account_sid":       "AC123",
+					"auth_token":        "auth-token",
+					"from_phone_number": "+15555550100",
+					"name":              "Twilio",
+				}
+			},
+		},
+		{
+			name:     "fusionauth_user_action_reason",
+			resource: resourceUserActionReason(),
+			config: func(*fakeFusionAuth) map[string]interface{} {
+				return map[string]interface{}{
+					"code":            "TOS",
+					"text":            "Violated the terms of service",
+					"localized_texts": map[string]interface{}{"de": "Verstoß gegen die Nutzungsbedingungen"},
+				}
+			},
+		},
+		{
+			name:     "fusionauth_user_consent",
+			resource: newUserConsent(),
+			config: func(fake *fakeFusionAuth) map[string]interface{} {
+				return map[string]interface{}{
+					"consent_id": fake.create("consent", map[string]interface{}{}),
+					"data":       map[string]interface{}{"source": "signup"},
+					"user_id":    fake.create("user", map[string]interface{}{}),
+					"values":     []interface{}{"email", "sms"},
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, client := newFakeClient(t)
+			config := tt.config(fake)
+
+			data := applyConfig(t, tt.resource, config, client)
+			if diff := planDiff(t, tt.resource, data, config, client); diff != nil {
+				t.Errorf("expected no changes after apply, got %#v", diff.Attributes)
+			}
+
+			importID := data.Id()
+			if tt.importID != nil {
+				importID = tt.importID(data)
+			}
+			importStateVerify(t, tt.resource, data, importID, client, tt.ignore...)
+		})
+	}
+}
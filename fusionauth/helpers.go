@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -27,6 +28,18 @@ func handleStringSliceFromSet(set *schema.Set) []string {
 	return s
 }
 
+// defaultResourceTimeouts returns the timeouts used by resources whose
+// operations can outlast the provider's request_timeout, such as copying a
+// tenant or deleting one on a busy instance. They can be overridden with a
+// timeouts block.
+func defaultResourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(5 * time.Minute),
+		Update: schema.DefaultTimeout(5 * time.Minute),
+		Delete: schema.DefaultTimeout(5 * time.Minute),
+	}
+}
+
 func checkResponse(statusCode int, faErrors *fusionauth.Errors) error {
 	switch {
 	case statusCode >= 200 && statusCode <= 299:
@@ -160,7 +173,8 @@ func intMapToStringMap(intMap map[string]interface{}) map[string]string {
 
 // clientTenantIDOverride takes in the client and the data. As long as the
 // resource has a tenant_id attribute set, it will override the client's tenant
-// id until the revert function is called.
+// id until the revert function is called. Otherwise, the provider's tenant_id,
+// if any, is used.
 func clientTenantIDOverride(client *Client, data *schema.ResourceData) (revert func()) {
 	if tid := data.Get("tenant_id").(string); tid != "" {
 		oldTenantID := client.FAClient.TenantId
@@ -0,0 +1,163 @@
+package fusionauth
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// checkResponseDiagnostics works like checkResponse, but returns one
+// diagnostic per error reported by FusionAuth. Field errors are mapped back to
+// the resource attribute they refer to where possible, so Terraform can point
+// at the offending configuration.
+func checkResponseDiagnostics(data *schema.ResourceData, statusCode int, faErrors *fusionauth.Errors) (diags diag.Diagnostics) {
+	if statusCode >= 200 && statusCode <= 299 {
+		return nil
+	}
+
+	if faErrors == nil || !faErrors.Present() {
+		return diag.FromErr(checkResponse(statusCode, faErrors))
+	}
+
+	status := fmt.Sprintf("unexpected status code: %d(%s)", statusCode, http.StatusText(statusCode))
+
+	for _, e := range faErrors.GeneralErrors {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  e.Message,
+			Detail:   fmt.Sprintf("%s, error code: %s", status, e.Code),
+		})
+	}
+
+	fields := make([]string, 0, len(faErrors.FieldErrors))
+	for field := range faErrors.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	ty := data.GetRawConfig().Type()
+	for _, field := range fields {
+		path := attributePathFromField(ty, field)
+		for _, e := range faErrors.FieldErrors[field] {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       e.Message,
+				Detail:        fmt.Sprintf("%s, field: %s, error code: %s", status, field, e.Code),
+				AttributePath: path,
+			})
+		}
+	}
+
+	return diags
+}
+
+// attributePathFromField maps a FusionAuth field name, such as
+// "tenant.jwtConfiguration.accessTokenKeyId", to the matching attribute path
+// in the given resource type, such as "jwt_configuration.0.access_token_key_id".
+//
+// Resource schemas do not always mirror the FusionAuth domain one to one; where
+// nested FusionAuth objects have been flattened into a single attribute the
+// segments are joined. The path is resolved as far as possible and nil is
+// returned if not even the first segment could be matched.
+func attributePathFromField(ty cty.Type, field string) cty.Path {
+	segments := strings.Split(field, ".")
+	if len(segments) > 1 && !(ty.IsObjectType() && ty.HasAttribute(toSnakeCase(fieldSegmentName(segments[0])))) {
+		// Drop the name of the request object, i.e. "tenant" or "application".
+		segments = segments[1:]
+	}
+
+	var path cty.Path
+	for i := 0; i < len(segments) && ty.IsObjectType(); {
+		name, index, matched := "", -1, false
+		for j := len(segments); j > i; j-- {
+			parts := make([]string, 0, j-i)
+			for _, s := range segments[i:j] {
+				parts = append(parts, toSnakeCase(fieldSegmentName(s)))
+			}
+
+			if name = strings.Join(parts, "_"); ty.HasAttribute(name) {
+				index = fieldSegmentIndex(segments[j-1])
+				matched = true
+				i = j
+				break
+			}
+		}
+		if !matched {
+			break
+		}
+
+		path = path.GetAttr(name)
+		ty = ty.AttributeType(name)
+
+		if ty.IsListType() && ty.ElementType().IsObjectType() {
+			if index < 0 {
+				index = 0
+			}
+			path = path.IndexInt(index)
+			ty = ty.ElementType()
+		}
+	}
+
+	if len(path) == 0 {
+		return nil
+	}
+
+	return path
+}
+
+// fieldSegmentName strips an array index from a field segment, i.e.
+// "connectorPolicies[1]" becomes "connectorPolicies".
+func fieldSegmentName(segment string) string {
+	if i := strings.Index(segment, "["); i >= 0 {
+		return segment[:i]
+	}
+
+	return segment
+}
+
+// fieldSegmentIndex returns the array index of a field segment, or -1 if it
+// has none.
+func fieldSegmentIndex(segment string) int {
+	start, end := strings.Index(segment, "["), strings.Index(segment, "]")
+	if start < 0 || end < start {
+		return -1
+	}
+
+	index, err := strconv.Atoi(segment[start+1 : end])
+	if err != nil {
+		return -1
+	}
+
+	return index
+}
+
+// toSnakeCase converts a camel cased FusionAuth property name into the snake
+// cased form used by the resource schemas, keeping acronyms together, i.e.
+// "authorizedRedirectURLs" becomes "authorized_redirect_urls".
+func toSnakeCase(s string) string {
+	runes := []rune(s)
+
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			pluralAcronym := nextIsLower && runes[i+1] == 's' && (i+2 == len(runes) || unicode.IsUpper(runes[i+2]))
+
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower && !pluralAcronym) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,79 @@
+package fusionauth
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func Test_attributePathFromField(t *testing.T) {
+	tenantType := newTenant().CoreConfigSchema().ImpliedType()
+	applicationType := newApplication().CoreConfigSchema().ImpliedType()
+
+	tests := []struct {
+		name  string
+		ty    cty.Type
+		field string
+		want  cty.Path
+	}{
+		{
+			name:  "top level attribute",
+			ty:    tenantType,
+			field: "tenant.name",
+			want:  cty.GetAttrPath("name"),
+		},
+		{
+			name:  "nested block",
+			ty:    tenantType,
+			field: "tenant.jwtConfiguration.accessTokenKeyId",
+			want:  cty.GetAttrPath("jwt_configuration").IndexInt(0).GetAttr("access_token_key_id"),
+		},
+		{
+			name:  "flattened nested object",
+			ty:    tenantType,
+			field: "tenant.userDeletePolicy.unverified.numberOfDaysToRetain",
+			want:  cty.GetAttrPath("user_delete_policy").IndexInt(0).GetAttr("unverified_number_of_days_to_retain"),
+		},
+		{
+			name:  "acronym",
+			ty:    applicationType,
+			field: "application.oauthConfiguration.authorizedRedirectURLs",
+			want:  cty.GetAttrPath("oauth_configuration").IndexInt(0).GetAttr("authorized_redirect_urls"),
+		},
+		{
+			name:  "partially resolved",
+			ty:    tenantType,
+			field: "tenant.jwtConfiguration.doesNotExist",
+			want:  cty.GetAttrPath("jwt_configuration").IndexInt(0),
+		},
+		{
+			name:  "unknown field",
+			ty:    tenantType,
+			field: "tenant.doesNotExist",
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := attributePathFromField(tt.ty, tt.field); !got.Equals(tt.want) {
+				t.Errorf("attributePathFromField() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_toSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"name":                   "name",
+		"accessTokenKeyId":       "access_token_key_id",
+		"authorizedRedirectURLs": "authorized_redirect_urls",
+		"HTTPHeaders":            "http_headers",
+		"samlv2Logout":           "samlv2_logout",
+		"roleIds":                "role_ids",
+	}
+	for in, want := range tests {
+		if got := toSnakeCase(in); got != want {
+			t.Errorf("toSnakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
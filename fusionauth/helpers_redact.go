@@ -0,0 +1,136 @@
+package fusionauth
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+const redacted = "<redacted>"
+
+// secretProperties returns the JSON properties, in lower case, of FusionAuth
+// requests and responses whose values must never show up in diagnostics or
+// logs. Properties nested in a specific object are qualified with the name of
+// their parent, i.e. the key of an API key is "apikey.key".
+func secretProperties() map[string]bool {
+	return map[string]bool{
+		"accesstoken":     true,
+		"apikey":          true,
+		"apikey.key":      true,
+		"authorization":   true,
+		"authtoken":       true,
+		"license":         true,
+		"licenseid":       true,
+		"privatekey":      true,
+		"refreshtoken":    true,
+		"secretaccesskey": true,
+		"webapikey":       true,
+	}
+}
+
+// isSecretProperty reports whether the value of the JSON property name, found
+// in an object that is the value of the property parent, is a secret. Apart
+// from the properties listed by secretProperties any password or secret, such
+// as "clientSecret" or "httpAuthenticationPassword", is considered a secret, as
+// are Kafka producer properties such as "ssl.key.password" and
+// "sasl.jaas.config", which holds the SASL credentials.
+func isSecretProperty(parent, name string) bool {
+	name = strings.ToLower(name)
+	if strings.HasSuffix(name, "password") || strings.HasSuffix(name, "secret") || strings.Contains(name, "jaas") {
+		return true
+	}
+
+	return secretProperties()[name] || secretProperties()[strings.ToLower(parent)+"."+name]
+}
+
+// secretValues returns the values of all secret properties found anywhere in
+// the JSON representation of v, longest first.
+func secretValues(v interface{}) []string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil
+	}
+
+	var secrets []string
+	walkSecrets(doc, "", func(s string) string {
+		secrets = append(secrets, s)
+		return s
+	})
+	sort.Slice(secrets, func(i, j int) bool {
+		return len(secrets[i]) > len(secrets[j])
+	})
+
+	return secrets
+}
+
+// redactJSON returns a copy of the JSON document with the values of all secret
+// properties redacted. It returns false if b is not a JSON document.
+func redactJSON(b []byte) ([]byte, bool) {
+	var doc interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, false
+	}
+
+	walkSecrets(doc, "", func(string) string {
+		return redacted
+	})
+
+	out, err := json.Marshal(doc)
+	if err != nil {
+		return nil, false
+	}
+
+	return out, true
+}
+
+// walkSecrets calls fn for the value of every non-empty secret property in
+// the decoded JSON document, replacing the value by the result.
+func walkSecrets(doc interface{}, parent string, fn func(string) string) {
+	switch d := doc.(type) {
+	case map[string]interface{}:
+		for k, v := range d {
+			if s, ok := v.(string); ok {
+				if s != "" && isSecretProperty(parent, k) {
+					d[k] = fn(s)
+				}
+				continue
+			}
+			walkSecrets(v, k, fn)
+		}
+	case []interface{}:
+		for _, v := range d {
+			walkSecrets(v, parent, fn)
+		}
+	}
+}
+
+// redactString replaces every occurrence of the given secrets in s.
+func redactString(s string, secrets []string) string {
+	for _, secret := range secrets {
+		s = strings.ReplaceAll(s, secret, redacted)
+	}
+
+	return s
+}
+
+// redactDiagnostics replaces every occurrence of the given secrets in the
+// summary and detail of the diagnostics.
+func redactDiagnostics(diags diag.Diagnostics, secrets []string) diag.Diagnostics {
+	if len(secrets) == 0 {
+		return diags
+	}
+
+	for i := range diags {
+		diags[i].Summary = redactString(diags[i].Summary, secrets)
+		diags[i].Detail = redactString(diags[i].Detail, secrets)
+	}
+
+	return diags
+}
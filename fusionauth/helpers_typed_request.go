@@ -0,0 +1,87 @@
+package fusionauth
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// typedAPI is a FusionAuth API whose request and response bodies wrap a
+// single object in one JSON property, i.e. {"messenger": {...}}. The type of
+// the object depends on the resource, so these APIs are called through
+// makeTypedRequest rather than the go-client.
+type typedAPI struct {
+	uri      string
+	property string
+}
+
+var (
+	identityProviderAPI = typedAPI{uri: "/api/identity-provider", property: "identityProvider"}
+	messageTemplateAPI  = typedAPI{uri: "/api/message/template", property: "messageTemplate"}
+	messageTemplatesAPI = typedAPI{uri: "/api/message/template", property: "messageTemplates"}
+	messengerAPI        = typedAPI{uri: "/api/messenger", property: "messenger"}
+)
+
+// typedResponse is the response body of a typedAPI, T being the FusionAuth
+// type of the object, i.e. fusionauth.GoogleIdentityProvider.
+type typedResponse[T any] struct {
+	fusionauth.BaseHTTPResponse
+	property string
+	Value    T
+}
+
+func (r *typedResponse[T]) SetStatus(status int) {
+	r.StatusCode = status
+}
+
+func (r *typedResponse[T]) UnmarshalJSON(b []byte) error {
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(b, &body); err != nil {
+		return err
+	}
+	if v, ok := body[r.property]; ok {
+		return json.Unmarshal(v, &r.Value)
+	}
+
+	return nil
+}
+
+// makeTypedRequest sends a request for the object with the given ID to a
+// typedAPI. A GET for an object that does not exist returns neither an object
+// nor diagnostics, and a response without a body, such as to a DELETE, returns
+// the zero value of T. Values of secret properties in the request, such as
+// client secrets, are redacted from any diagnostics.
+func makeTypedRequest[T any](ctx context.Context, data *schema.ResourceData, client Client, api typedAPI, id string, body *T, method string) (*T, diag.Diagnostics) {
+	resp := typedResponse[T]{property: api.property}
+	var faErrs fusionauth.Errors
+
+	restClient := client.FAClient.Start(&resp, &faErrs).
+		WithUri(api.uri).
+		WithUriSegment(id).
+		WithMethod(method)
+
+	var secrets []string
+	if body != nil {
+		restClient.WithJSONBody(map[string]T{api.property: *body})
+		secrets = secretValues(body)
+	}
+
+	if err := restClient.Do(ctx); err != nil && err != io.EOF {
+		return nil, redactDiagnostics(diag.FromErr(err), secrets)
+	}
+
+	if method == http.MethodGet && resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, &faErrs); diags != nil {
+		return nil, redactDiagnostics(diags, secrets)
+	}
+
+	return &resp.Value, nil
+}
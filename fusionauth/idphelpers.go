@@ -1,142 +1,81 @@
 package fusionauth
 
 import (
-	"bytes"
 	"context"
-	"fmt"
-	"io"
+	"encoding/json"
 	"net/http"
-	"strings"
-	"time"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func deleteIdentityProvider(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteIdentityProvider(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteIdentityProvider(id)
+	resp, faErrs, err := client.FAClient.DeleteIdentityProviderWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
 }
 
-func readIdentityProvider(id string, client Client) ([]byte, error) {
-	req, err := http.NewRequest(
-		http.MethodGet,
-		fmt.Sprintf("%s/%s/%s", strings.TrimRight(client.Host, "/"), "api/identity-provider", id),
-		nil,
-	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Authorization", client.APIKey)
-
-	hc := http.Client{
-		Timeout: 30 * time.Second,
-	}
-
-	resp, err := hc.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	if err := checkResponse(resp.StatusCode, nil); err != nil {
-		return nil, err
-	}
-
-	b, _ := io.ReadAll(resp.Body)
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, response: \n\t%s", resp.StatusCode, string(b))
-	}
-	return b, nil
+// createIdentityProvider creates the identity provider, using idpID as its ID
+// if given, and returns the identity provider as stored by FusionAuth.
+func createIdentityProvider[T any](ctx context.Context, data *schema.ResourceData, client Client, idpID string, idp T) (*T, diag.Diagnostics) {
+	return makeTypedRequest(ctx, data, client, identityProviderAPI, idpID, &idp, http.MethodPost)
 }
 
-func createIdentityProvider(b []byte, client Client, idpID string) ([]byte, error) {
-	var u string
-	if idpID != "" {
-		u = fmt.Sprintf("%s/%s/%s", strings.TrimRight(client.Host, "/"), "api/identity-provider", idpID)
-	} else {
-		u = fmt.Sprintf("%s/%s", strings.TrimRight(client.Host, "/"), "api/identity-provider")
-	}
-
-	req, err := http.NewRequest(
-		http.MethodPost,
-		u,
-		bytes.NewBuffer(b),
-	)
-
-	if err != nil {
-		return nil, err
+// retrieveIdentityProvider retrieves the identity provider of the resource.
+// If it no longer exists the resource is removed from state and nil is
+// returned.
+func retrieveIdentityProvider[T any](ctx context.Context, data *schema.ResourceData, client Client) (*T, diag.Diagnostics) {
+	idp, diags := makeTypedRequest[T](ctx, data, client, identityProviderAPI, data.Id(), nil, http.MethodGet)
+	if idp == nil && diags == nil {
+		data.SetId("")
 	}
 
-	req.Header.Add("Authorization", client.APIKey)
-	req.Header.Add("Content-Type", "application/json")
-
-	hc := http.Client{
-		Timeout: 30 * time.Second,
-	}
-
-	resp, err := hc.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	if err := checkResponse(resp.StatusCode, nil); err != nil {
-		return nil, err
-	}
-	bb, _ := io.ReadAll(resp.Body)
+	return idp, diags
+}
 
-	if resp.StatusCode > 299 {
-		return nil, fmt.Errorf("status: %d, response: \n\t%s\nreq body:\n\t%s", resp.StatusCode, string(bb), string(b))
-	}
-	return bb, nil
+// updateIdentityProvider updates the identity provider of the resource and
+// returns the identity provider as stored by FusionAuth.
+func updateIdentityProvider[T any](ctx context.Context, data *schema.ResourceData, client Client, idp T) (*T, diag.Diagnostics) {
+	return makeTypedRequest(ctx, data, client, identityProviderAPI, data.Id(), &idp, http.MethodPut)
 }
 
-func updateIdentityProvider(b []byte, id string, client Client) ([]byte, error) {
-	req, err := http.NewRequest(
-		http.MethodPut,
-		fmt.Sprintf("%s/%s/%s", strings.TrimRight(client.Host, "/"), "api/identity-provider", id),
-		bytes.NewBuffer(b),
-	)
+// retrieveIdentityProviders retrieves all identity providers. Only the
+// properties common to all types of identity providers are decoded.
+func retrieveIdentityProviders(ctx context.Context, client Client) (*fusionauth.IdentityProviderResponse, *fusionauth.Errors, error) {
+	var resp fusionauth.IdentityProviderResponse
+	var faErrs fusionauth.Errors
 
-	if err != nil {
-		return nil, err
+	restClient := client.FAClient.Start(&resp, &faErrs)
+	err := restClient.WithUri("/api/identity-provider").
+		WithMethod(http.MethodGet).
+		Do(ctx)
+	if restClient.ErrorRef == nil {
+		return &resp, nil, err
 	}
+	return &resp, &faErrs, err
+}
 
-	req.Header.Add("Authorization", client.APIKey)
-	req.Header.Add("Content-Type", "application/json")
-
-	hc := http.Client{
-		Timeout: 30 * time.Second,
-	}
+// decodeApplicationConfiguration converts the application configuration of an
+// identity provider, which FusionAuth returns as untyped JSON, into the type
+// specific configuration of each application.
+func decodeApplicationConfiguration[T any](applicationConfiguration map[string]interface{}) map[string]T {
+	m := make(map[string]T, len(applicationConfiguration))
 
-	resp, err := hc.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	if err := checkResponse(resp.StatusCode, nil); err != nil {
-		return nil, err
-	}
-	bb, _ := io.ReadAll(resp.Body)
+	b, _ := json.Marshal(applicationConfiguration)
+	_ = json.Unmarshal(b, &m)
 
-	if resp.StatusCode > 299 {
-		return nil, fmt.Errorf("status: %d, response: \n\t%s\nreq body:\n\t%s", resp.StatusCode, string(bb), string(b))
-	}
-	return bb, nil
+	return m
 }
 
 func buildTenantConfigurationResource(tcm map[string]fusionauth.IdentityProviderTenantConfiguration) []map[string]interface{} {
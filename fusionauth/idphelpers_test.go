@@ -0,0 +1,111 @@
+package fusionauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+)
+
+func testIdentityProviderClient(t *testing.T, handler http.HandlerFunc) Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	u, _ := url.Parse(server.URL)
+	faClient := fusionauth.NewClient(server.Client(), u, "key")
+	faClient.SetTenantId("c1a7e0f2-2e1d-4c4c-9d4c-6a1f3c0f9a55")
+
+	return Client{FAClient: *faClient, Host: server.URL, APIKey: "key"}
+}
+
+func Test_createIdentityProvider(t *testing.T) {
+	client := testIdentityProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/identity-provider/idp-id" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("X-FusionAuth-TenantId") == "" {
+			t.Error("expected the tenant header to be set")
+		}
+
+		var req struct {
+			IdentityProvider fusionauth.SteamIdentityProvider `json:"identityProvider"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		req.IdentityProvider.Id = "idp-id"
+		_ = json.NewEncoder(w).Encode(req)
+	})
+
+	data := resourceIDPSteam().TestResourceData()
+	idp, diags := createIdentityProvider(context.Background(), data, client, "idp-id", fusionauth.SteamIdentityProvider{
+		WebAPIKey: "key",
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if idp.Id != "idp-id" || idp.WebAPIKey != "key" {
+		t.Errorf("unexpected identity provider %#v", idp)
+	}
+}
+
+func Test_retrieveIdentityProvider_notFound(t *testing.T) {
+	client := testIdentityProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	data := resourceIDPSteam().TestResourceData()
+	data.SetId("idp-id")
+
+	idp, diags := retrieveIdentityProvider[fusionauth.SteamIdentityProvider](context.Background(), data, client)
+	if idp != nil || diags != nil {
+		t.Fatalf("expected neither an identity provider nor diagnostics, got %#v, %#v", idp, diags)
+	}
+	if data.Id() != "" {
+		t.Errorf("expected the resource to be removed from state, id is %q", data.Id())
+	}
+}
+
+func Test_updateIdentityProvider_redactsSecrets(t *testing.T) {
+	client := testIdentityProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"generalErrors":[{"code":"[invalid]","message":"The client secret [s3cr3t] is invalid."}]}`))
+	})
+
+	data := resourceIDPSteam().TestResourceData()
+	data.SetId("idp-id")
+
+	_, diags := updateIdentityProvider(context.Background(), data, client, fusionauth.OpenIdConnectIdentityProvider{
+		Oauth2: fusionauth.IdentityProviderOauth2Configuration{ClientSecret: "s3cr3t"},
+	})
+	if !diags.HasError() {
+		t.Fatal("expected an error")
+	}
+	for _, d := range diags {
+		if strings.Contains(d.Summary+d.Detail, "s3cr3t") {
+			t.Errorf("secret leaked into diagnostic %#v", d)
+		}
+	}
+}
+
+func Test_secretValues(t *testing.T) {
+	got := secretValues(map[string]interface{}{
+		"identityProvider": map[string]interface{}{
+			"name": "not a secret",
+			"applicationConfiguration": map[string]interface{}{
+				"app": map[string]interface{}{"client_secret": "short"},
+			},
+			"oauth2": map[string]interface{}{"clientSecret": "a longer secret"},
+		},
+	})
+
+	want := []string{"a longer secret", "short"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("secretValues() = %q, want %q", got, want)
+	}
+}
@@ -12,41 +12,41 @@ import (
 type keyReadFunc func(*schema.ResourceData, fusionauth.Key) diag.Diagnostics
 type keyBuildFunc func(*schema.ResourceData) fusionauth.Key
 
-func keyUpdate(data *schema.ResourceData, f keyBuildFunc, i interface{}) diag.Diagnostics {
+func keyUpdate(ctx context.Context, data *schema.ResourceData, f keyBuildFunc, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	l := f(data)
 
-	resp, faErrs, err := client.FAClient.UpdateKey(data.Id(), fusionauth.KeyRequest{Key: l})
+	resp, faErrs, err := client.FAClient.UpdateKeyWithContext(ctx, data.Id(), fusionauth.KeyRequest{Key: l})
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
 }
 
-func keyDelete(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func keyDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteKey(id)
+	resp, faErrs, err := client.FAClient.DeleteKeyWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
 }
 
-func keyRead(data *schema.ResourceData, f keyReadFunc, i interface{}) diag.Diagnostics {
+func keyRead(ctx context.Context, data *schema.ResourceData, f keyReadFunc, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.RetrieveKey(id)
+	resp, faErrs, err := client.FAClient.RetrieveKeyWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -55,8 +55,8 @@ func keyRead(data *schema.ResourceData, f keyReadFunc, i interface{}) diag.Diagn
 		data.SetId("")
 		return nil
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return f(data, resp.Key)
@@ -0,0 +1,108 @@
+package fusionauth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// messengerSchema returns the attributes shared by all types of messengers,
+// merged with the type specific attributes s.
+func messengerSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		Description:  "The Id to use for the new Messenger. If not specified a secure random UUID will be generated.",
+		ValidateFunc: validation.IsUUID,
+	}
+	s["data"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Description: "An object that can hold any information about the Messenger that should be persisted.",
+	}
+	s["debug"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Determines if debug should be enabled to create an event log to assist in debugging integration errors.",
+	}
+	s["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The unique Messenger name.",
+	}
+
+	return s
+}
+
+// buildBaseMessenger builds the configuration shared by all types of
+// messengers.
+func buildBaseMessenger(data *schema.ResourceData, messengerType fusionauth.MessengerType) fusionauth.BaseMessengerConfiguration {
+	return fusionauth.BaseMessengerConfiguration{
+		Id:    data.Get("id").(string),
+		Data:  data.Get("data").(map[string]interface{}),
+		Debug: data.Get("debug").(bool),
+		Name:  data.Get("name").(string),
+		Type:  messengerType,
+	}
+}
+
+// buildResourceDataFromBaseMessenger sets the attributes shared by all types
+// of messengers.
+func buildResourceDataFromBaseMessenger(data *schema.ResourceData, m fusionauth.BaseMessengerConfiguration) diag.Diagnostics {
+	if err := data.Set("data", m.Data); err != nil {
+		return diag.Errorf("messenger.data: %s", err.Error())
+	}
+	if err := data.Set("debug", m.Debug); err != nil {
+		return diag.Errorf("messenger.debug: %s", err.Error())
+	}
+	if err := data.Set("name", m.Name); err != nil {
+		return diag.Errorf("messenger.name: %s", err.Error())
+	}
+
+	return nil
+}
+
+func deleteMessenger(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, faErrs, err := client.FAClient.DeleteMessengerWithContext(ctx, data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	return nil
+}
+
+// createMessenger creates the messenger, using messengerID as its ID if
+// given, and returns the messenger as stored by FusionAuth.
+func createMessenger[T any](ctx context.Context, data *schema.ResourceData, client Client, messengerID string, messenger T) (*T, diag.Diagnostics) {
+	return makeTypedRequest(ctx, data, client, messengerAPI, messengerID, &messenger, http.MethodPost)
+}
+
+// retrieveMessenger retrieves the messenger of the resource. If it no longer
+// exists the resource is removed from state and nil is returned.
+func retrieveMessenger[T any](ctx context.Context, data *schema.ResourceData, client Client) (*T, diag.Diagnostics) {
+	messenger, diags := makeTypedRequest[T](ctx, data, client, messengerAPI, data.Id(), nil, http.MethodGet)
+	if messenger == nil && diags == nil {
+		data.SetId("")
+	}
+
+	return messenger, diags
+}
+
+// updateMessenger updates the messenger of the resource and returns the
+// messenger as stored by FusionAuth.
+func updateMessenger[T any](ctx context.Context, data *schema.ResourceData, client Client, messenger T) (*T, diag.Diagnostics) {
+	return makeTypedRequest(ctx, data, client, messengerAPI, data.Id(), &messenger, http.MethodPut)
+}
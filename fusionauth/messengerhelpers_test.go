@@ -0,0 +1,123 @@
+package fusionauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func Test_createTwilioMessenger(t *testing.T) {
+	client := testIdentityProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/messenger" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var req struct {
+			Messenger fusionauth.TwilioMessengerConfiguration `json:"messenger"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Messenger.Type != fusionauth.MessengerType_Twilio {
+			t.Errorf("type = %q, want %q", req.Messenger.Type, fusionauth.MessengerType_Twilio)
+		}
+		req.Messenger.Id = "messenger-id"
+		_ = json.NewEncoder(w).Encode(req)
+	})
+
+	data := newTwilioMessenger().TestResourceData()
+	_ = data.Set("name", "Twilio")
+	_ = data.Set("account_sid", "AC123")
+	_ = data.Set("auth_token", "token")
+
+	if diags := createTwilioMessenger(context.Background(), data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if data.Id() != "messenger-id" {
+		t.Errorf("id = %q, want %q", data.Id(), "messenger-id")
+	}
+}
+
+func Test_readTwilioMessenger_keepsAuthToken(t *testing.T) {
+	client := testIdentityProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"messenger":{"id":"messenger-id","name":"Twilio","type":"Twilio","accountSID":"AC123"}}`))
+	})
+
+	data := newTwilioMessenger().TestResourceData()
+	data.SetId("messenger-id")
+	_ = data.Set("auth_token", "token")
+
+	if diags := readTwilioMessenger(context.Background(), data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if got := data.Get("auth_token").(string); got != "token" {
+		t.Errorf("auth_token = %q, want %q", got, "token")
+	}
+	if got := data.Get("account_sid").(string); got != "AC123" {
+		t.Errorf("account_sid = %q, want %q", got, "AC123")
+	}
+}
+
+func Test_updateMessenger_redactsSecrets(t *testing.T) {
+	client := testIdentityProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"fieldErrors":{"messenger.authToken":[{"code":"[invalid]","message":"The auth token [s3cr3t] is invalid."}]}}`))
+	})
+
+	data := newTwilioMessenger().TestResourceData()
+	data.SetId("messenger-id")
+
+	_, diags := updateMessenger(context.Background(), data, client, fusionauth.TwilioMessengerConfiguration{
+		AuthToken: "s3cr3t",
+	})
+	if !diags.HasError() {
+		t.Fatal("expected an error")
+	}
+	for _, d := range diags {
+		if strings.Contains(d.Summary+d.Detail, "s3cr3t") {
+			t.Errorf("secret leaked into diagnostic %#v", d)
+		}
+	}
+}
+
+func Test_twilioMessenger_requiresSender(t *testing.T) {
+	config := map[string]interface{}{
+		"account_sid": "AC123",
+		"auth_token":  "token",
+		"name":        "Twilio",
+	}
+	if diags := newTwilioMessenger().Validate(terraform.NewResourceConfigRaw(config)); !diags.HasError() {
+		t.Error("expected an error without from_phone_number or messaging_service_sid")
+	}
+
+	config["messaging_service_sid"] = "MG123"
+	if diags := newTwilioMessenger().Validate(terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Errorf("unexpected error: %#v", diags)
+	}
+}
+
+func Test_twilioMessenger_authTokenNotReturned(t *testing.T) {
+	fake, client := newFakeClient(t)
+	r := newTwilioMessenger()
+
+	config := map[string]interface{}{
+		"account_sid":           "AC123",
+		"auth_token":            "auth-token",
+		"messaging_service_sid": "MG123",
+		"name":                  "Twilio",
+	}
+	data := applyConfig(t, r, config, client)
+
+	// FusionAuth does not return the auth token.
+	delete(fake.collection("messenger")[data.Id()], "authToken")
+	data = refreshState(t, r, data.State(), client)
+	if got := data.Get("auth_token"); got != "auth-token" {
+		t.Errorf("auth_token = %q, want %q", got, "auth-token")
+	}
+	if diff := planDiff(t, r, data, config, client); diff != nil {
+		t.Errorf("expected no changes after refresh, got %#v", diff.Attributes)
+	}
+}
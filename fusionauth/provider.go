@@ -2,6 +2,7 @@ package fusionauth
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Provider configures and returns a fusionauth terraform provider.
@@ -18,42 +19,163 @@ func Provider() *schema.Provider {
 				Required:    true,
 				DefaultFunc: schema.EnvDefaultFunc("FA_API_KEY", nil),
 			},
+			"tenant_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("FA_TENANT_ID", nil),
+				Description:  "The Id of the Tenant used to scope API requests through the X-FusionAuth-TenantId header. A tenant_id set on a resource takes precedence.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"ca_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("FA_CA_CERT_FILE", nil),
+				Description:   "Path to a PEM encoded CA bundle used to verify the FusionAuth server certificate, in addition to the system roots.",
+				ConflictsWith: []string{"ca_cert_pem"},
+			},
+			"ca_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "A PEM encoded CA bundle used to verify the FusionAuth server certificate, in addition to the system roots.",
+				ConflictsWith: []string{"ca_cert_file"},
+			},
+			"client_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Path to a PEM encoded client certificate used for mutual TLS.",
+				ConflictsWith: []string{"client_cert_pem"},
+			},
+			"client_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "A PEM encoded client certificate used for mutual TLS.",
+				ConflictsWith: []string{"client_cert_file"},
+			},
+			"client_key_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Path to the PEM encoded private key of the client certificate.",
+				ConflictsWith: []string{"client_key_pem"},
+			},
+			"client_key_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "The PEM encoded private key of the client certificate.",
+				ConflictsWith: []string{"client_key_file"},
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disables verification of the FusionAuth server certificate. Only use this for local development.",
+			},
+			"proxy_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The URL of the HTTP proxy to send requests through. If not set, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used.",
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+			},
+			"request_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultRequestTimeout,
+				Description:  "The time, in seconds, each attempt of a request to FusionAuth may take. Waits between retries are not included, so a request may take up to retry_max_attempts times this long plus the waits. Resources supporting a timeouts block use that instead for create, update and delete.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"skip_connection_check": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skips checking the connection to FusionAuth and detecting its version when the provider is configured.",
+			},
+			"strict_version_check": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fails the plan, rather than warning on apply, if the configuration uses attributes the connected FusionAuth version does not support.",
+			},
+			"retry_max_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultRetryMaxAttempts,
+				Description:  "The maximum number of attempts made for a request to FusionAuth that fails with a transient error. Set to 1 to disable retries.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"retry_wait_min": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultRetryWaitMin,
+				Description:  "The minimum time, in seconds, to wait before retrying a failed request. The wait doubles with every attempt.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_wait_max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultRetryWaitMax,
+				Description:  "The maximum time, in seconds, to wait before retrying a failed request.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_status_codes": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(100, 599)},
+				Optional:    true,
+				Description: "The HTTP status codes that are considered transient and retried. Defaults to 429, 502, 503 and 504. POST and PATCH requests, which may already have taken effect, are only retried on 429 and 503.",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"fusionauth_api_key":                  resourceAPIKey(),
 			"fusionauth_application":              newApplication(),
+			"fusionauth_application_oauth_scope":  newApplicationOAuthScope(),
 			"fusionauth_application_role":         newApplicationRole(),
+			"fusionauth_consent":                  newConsent(),
 			"fusionauth_email":                    newEmail(),
 			"fusionauth_entity":                   resourceEntity(),
 			"fusionauth_entity_grant":             resourceEntityGrant(),
 			"fusionauth_entity_type":              resourceEntityType(),
 			"fusionauth_entity_type_permission":   resourceEntityTypePermission(),
+			"fusionauth_family":                   newFamily(),
 			"fusionauth_generic_connector":        newGenericConnector(),
+			"fusionauth_generic_messenger":        newGenericMessenger(),
+			"fusionauth_ldap_connector":           newLDAPConnector(),
 			"fusionauth_form":                     resourceForm(),
 			"fusionauth_form_field":               resourceFormField(),
 			"fusionauth_group":                    newGroup(),
+			"fusionauth_group_membership":         newGroupMembership(),
 			"fusionauth_idp_apple":                resourceIDPApple(),
+			"fusionauth_idp_epic_games":           resourceIDPEpicGames(),
 			"fusionauth_idp_external_jwt":         resourceIDPExternalJWT(),
 			"fusionauth_idp_facebook":             resourceIDPFacebook(),
 			"fusionauth_idp_google":               newIDPGoogle(),
+			"fusionauth_idp_hypr":                 resourceIDPHYPR(),
 			"fusionauth_idp_linkedin":             resourceIDPLinkedIn(),
+			"fusionauth_idp_nintendo":             resourceIDPNintendo(),
 			"fusionauth_idp_open_id_connect":      newIDPOpenIDConnect(),
 			"fusionauth_idp_saml_v2":              resourceIDPSAMLv2(),
 			"fusionauth_idp_saml_v2_idp_initated": resourceIDPSAMLv2IdPInitiated(),
 			"fusionauth_idp_sony_psn":             resourceIDPSonyPSN(),
 			"fusionauth_idp_steam":                resourceIDPSteam(),
 			"fusionauth_idp_twitch":               resourceIDPTwitch(),
+			"fusionauth_idp_twitter":              resourceIDPTwitter(),
 			"fusionauth_idp_xbox":                 resourceIDPXbox(),
+			"fusionauth_identity_provider_link":   newIdentityProviderLink(),
 			"fusionauth_imported_key":             resourceImportedKey(),
+			"fusionauth_integrations":             resourceIntegrations(),
+			"fusionauth_ip_access_control_list":   newIPAccessControlList(),
+			"fusionauth_kafka_messenger":          newKafkaMessenger(),
 			"fusionauth_key":                      newKey(),
 			"fusionauth_lambda":                   newLambda(),
+			"fusionauth_message_template":         newMessageTemplate(),
 			"fusionauth_reactor":                  newReactor(),
 			"fusionauth_registration":             newRegistration(),
 			"fusionauth_system_configuration":     resourceSystemConfiguration(),
 			"fusionauth_theme":                    newTheme(),
 			"fusionauth_tenant":                   newTenant(),
+			"fusionauth_twilio_messenger":         newTwilioMessenger(),
 			"fusionauth_user":                     newUser(),
 			"fusionauth_user_action":              resourceUserAction(),
+			"fusionauth_user_action_reason":       resourceUserActionReason(),
+			"fusionauth_user_consent":             newUserConsent(),
 			"fusionauth_webhook":                  newWebhook(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
@@ -64,6 +186,7 @@ func Provider() *schema.Provider {
 			"fusionauth_email":            dataSourceEmail(),
 			"fusionauth_idp":              dataSourceIDP(),
 			"fusionauth_lambda":           dataSourceLambda(),
+			"fusionauth_message_template": dataSourceMessageTemplate(),
 			"fusionauth_tenant":           dataSourceTenant(),
 			"fusionauth_user":             dataSourceUser(),
 		},
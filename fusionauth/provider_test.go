@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"testing"
 	"time"
@@ -42,6 +43,22 @@ func init() {
 	}
 }
 
+// TestMain runs the tests against the fake FusionAuth server unless FA_DOMAIN
+// points them at a real FusionAuth instance.
+func TestMain(m *testing.M) {
+	if os.Getenv("FA_DOMAIN") != "" {
+		os.Exit(m.Run())
+	}
+
+	fake := newFakeFusionAuth()
+	_ = os.Setenv("FA_DOMAIN", fake.URL)
+	_ = os.Setenv("FA_API_KEY", fakeFusionAuthAPIKey)
+
+	code := m.Run()
+	fake.Close()
+	os.Exit(code)
+}
+
 // fusionauthClient extracts the underlying client from a configured provider
 func fusionauthClient() fusionauth.FusionAuthClient {
 	provider, err := testAccProviderFactories[providerFusionauth]()
@@ -92,6 +109,36 @@ func testCheckResourceAttrJSON(resourceName, attributeName, expectedData string)
 	}
 }
 
+// testAccCheckDestroyed returns a CheckDestroy function that fails while an
+// object of any resource of resourceType can still be retrieved from
+// "<uri>/<id>".
+func testAccCheckDestroyed(resourceType, uri string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := fusionauthClient()
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			var resp fusionauth.BaseHTTPResponse
+			var faErrs fusionauth.Errors
+			err := client.Start(&resp, &faErrs).
+				WithUri(uri).
+				WithUriSegment(rs.Primary.ID).
+				WithMethod(http.MethodGet).
+				Do(context.Background())
+			if err != nil {
+				return err
+			}
+			if resp.StatusCode != http.StatusNotFound {
+				return fmt.Errorf("%s %s still exists", resourceType, rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
 // checkFusionauthErrors checks for low-level client errors and then any
 // reported fusionauth errors.
 func checkFusionauthErrors(faErrs *fusionauth.Errors, err error) error {
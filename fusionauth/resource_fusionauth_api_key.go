@@ -191,32 +191,32 @@ func resourceAPIKey() *schema.Resource {
 	}
 }
 
-func createAPIKey(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createAPIKey(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	ak := buildAPIKey(data)
 
-	oldTenantID := client.FAClient.TenantId
-	client.FAClient.TenantId = ak.TenantId
-	defer func() {
-		client.FAClient.TenantId = oldTenantID
-	}()
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
 	kid := data.Get("key_id").(string)
-	resp, faErrs, err := client.FAClient.CreateAPIKey(kid, fusionauth.APIKeyRequest{ApiKey: ak})
+	resp, faErrs, err := client.FAClient.CreateAPIKeyWithContext(ctx, kid, fusionauth.APIKeyRequest{ApiKey: ak})
 	if err != nil {
 		return diag.Errorf("createAPIKey errors: %v", err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 	data.SetId(resp.ApiKey.Id)
 	return buildResourceDataFromAPIKey(data, resp.ApiKey)
 }
 
-func readAPIKey(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readAPIKey(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.RetrieveAPIKey(id)
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
+
+	resp, faErrs, err := client.FAClient.RetrieveAPIKeyWithContext(ctx, id)
 	if err != nil {
 		return diag.Errorf("readAPIKey errors: %v", err)
 	}
@@ -224,8 +224,8 @@ func readAPIKey(_ context.Context, data *schema.ResourceData, i interface{}) dia
 		data.SetId("")
 		return nil
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	if err := checkResponse(resp.StatusCode, nil); err != nil {
@@ -235,36 +235,37 @@ func readAPIKey(_ context.Context, data *schema.ResourceData, i interface{}) dia
 	return buildResourceDataFromAPIKey(data, resp.ApiKey)
 }
 
-func updateAPIKey(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateAPIKey(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	ak := buildAPIKey(data)
 
-	oldTenantID := client.FAClient.TenantId
-	client.FAClient.TenantId = ak.TenantId
-	defer func() {
-		client.FAClient.TenantId = oldTenantID
-	}()
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
 
-	resp, faErrs, err := client.FAClient.UpdateAPIKey(data.Id(), fusionauth.APIKeyRequest{ApiKey: ak})
+	resp, faErrs, err := client.FAClient.UpdateAPIKeyWithContext(ctx, data.Id(), fusionauth.APIKeyRequest{ApiKey: ak})
 	if err != nil {
 		return diag.Errorf("updateAPIKey errors: %v", err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	data.SetId(resp.ApiKey.Id)
 	return buildResourceDataFromAPIKey(data, resp.ApiKey)
 }
 
-func deleteAPIKey(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteAPIKey(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	resp, faErrs, err := client.FAClient.DeleteAPIKey(data.Id())
+
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
+
+	resp, faErrs, err := client.FAClient.DeleteAPIKeyWithContext(ctx, data.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
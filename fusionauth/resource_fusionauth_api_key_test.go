@@ -0,0 +1,34 @@
+package fusionauth
+
+import (
+	"context"
+	"testing"
+)
+
+func Test_apiKey_otherTenant(t *testing.T) {
+	fake, client := newFakeClient(t)
+	ctx := context.Background()
+
+	providerTenantID := fake.create("tenant", map[string]interface{}{"name": "Default"})
+	tenantID := fake.create("tenant", map[string]interface{}{"name": "Other"})
+	keyID := fake.create("api-key", map[string]interface{}{"key": "tenant-scoped-key", "tenantId": tenantID})
+	client.FAClient.TenantId = providerTenantID
+
+	data := resourceAPIKey().TestResourceData()
+	data.SetId(keyID)
+	_ = data.Set("tenant_id", tenantID)
+
+	if diags := readAPIKey(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if data.Id() != keyID {
+		t.Fatal("expected the API key of another tenant than the provider's to be read")
+	}
+
+	if diags := deleteAPIKey(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if _, ok := fake.collection("api-key")[keyID]; ok {
+		t.Error("expected the API key of another tenant than the provider's to be deleted")
+	}
+}
@@ -12,6 +12,8 @@ func newApplication() *schema.Resource {
 		ReadContext:   readApplication,
 		UpdateContext: updateApplication,
 		DeleteContext: deleteApplication,
+		CustomizeDiff: customizeDiffMinVersions(applicationMinVersions),
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -625,6 +627,17 @@ func newOAuthConfiguration() *schema.Resource {
 				Description: "The OAuth 2.0 client id. If you leave this blank during a POST, a client id will be generated for you. If you leave this blank during PUT, the previous value will be maintained. For both POST and PUT you can provide a value and it will be stored.",
 				Computed:    true,
 			},
+			"consent_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"AlwaysPrompt",
+					"RememberDecision",
+					"NeverPrompt",
+				}, false),
+				Description: "Controls the policy for prompting a user to consent to requested OAuth scopes. Requires FusionAuth 1.50.0 or later.",
+			},
 			"debug": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -686,6 +699,27 @@ func newOAuthConfiguration() *schema.Resource {
 				Default:     false,
 				Description: "When enabled the user will be required to be registered, or complete registration before redirecting to the configured callback in the authorization code grant or the implicit grant. This configuration does not currently apply to any other grant.",
 			},
+			"scope_handling_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Compatibility",
+					"Strict",
+				}, false),
+				Description: "Controls the policy for handling of OAuth scopes when populating JWTs and the UserInfo response. Requires FusionAuth 1.50.0 or later.",
+			},
+			"unknown_scope_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Allow",
+					"Remove",
+					"Reject",
+				}, false),
+				Description: "Controls the policy for handling unknown scopes on an OAuth request. Requires FusionAuth 1.50.0 or later.",
+			},
 		},
 	}
 }
@@ -9,40 +9,51 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func createApplication(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	ar := fusionauth.ApplicationRequest{
+// applicationMinVersions returns the attributes that require a newer
+// FusionAuth version than the oldest one supported by the provider.
+func applicationMinVersions() map[string]string {
+	return map[string]string{
+		"oauth_configuration.0.consent_mode":          "1.50.0",
+		"oauth_configuration.0.scope_handling_policy": "1.50.0",
+		"oauth_configuration.0.unknown_scope_policy":  "1.50.0",
+	}
+}
+
+func createApplication(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client).withContextDeadline()
+	warnings := unsupportedAttributeWarnings(data, client, applicationMinVersions())
+	ar := applicationRequest{
 		Application: buildApplication(data),
 	}
 
-	oldTenantID := client.FAClient.TenantId
-	client.FAClient.TenantId = ar.Application.TenantId
-	defer func() {
-		client.FAClient.TenantId = oldTenantID
-	}()
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
 
 	var aid string
 	if a, ok := data.GetOk("application_id"); ok {
 		aid = a.(string)
 	}
 
-	resp, faErrs, err := client.FAClient.CreateApplication(aid, ar)
+	resp, faErrs, err := makeApplicationRequest(ctx, client, aid, &ar, http.MethodPost)
 	if err != nil {
 		return diag.Errorf("CreateApplication errors: %v", err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return append(warnings, diags...)
 	}
 
 	data.SetId(resp.Application.Id)
-	return buildResourceDataFromApplication(resp.Application, data)
+	return append(warnings, buildResourceDataFromApplication(resp.Application, data)...)
 }
 
-func readApplication(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readApplication(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, err := client.FAClient.RetrieveApplication(id)
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
+
+	resp, _, err := makeApplicationRequest(ctx, client, id, nil, http.MethodGet)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -58,32 +69,75 @@ func readApplication(_ context.Context, data *schema.ResourceData, i interface{}
 	return buildResourceDataFromApplication(resp.Application, data)
 }
 
-func updateApplication(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	ar := fusionauth.ApplicationRequest{
+func updateApplication(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client).withContextDeadline()
+	warnings := unsupportedAttributeWarnings(data, client, applicationMinVersions())
+	ar := applicationRequest{
 		Application: buildApplication(data),
 	}
 
-	resp, faErrs, err := client.FAClient.UpdateApplication(data.Id(), ar)
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
+
+	resp, faErrs, err := makeApplicationRequest(ctx, client, data.Id(), &ar, http.MethodPut)
 	if err != nil {
 		return diag.Errorf("UpdateApplication err: %v", err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return append(warnings, diags...)
 	}
 
-	return nil
+	return warnings
 }
 
-func deleteApplication(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	resp, faErrs, err := client.FAClient.DeleteApplication(data.Id())
+func deleteApplication(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client).withContextDeadline()
+
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
+
+	resp, faErrs, err := client.FAClient.DeleteApplicationWithContext(ctx, data.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
 }
+
+// applicationRequest is the request body of the application API.
+type applicationRequest struct {
+	Application application `json:"application"`
+}
+
+type applicationResponse struct {
+	fusionauth.BaseHTTPResponse
+	Application application `json:"application"`
+}
+
+func (r *applicationResponse) SetStatus(status int) {
+	r.StatusCode = status
+}
+
+// makeApplicationRequest sends a request to the application API, using the
+// application type of the provider rather than the go-client's.
+func makeApplicationRequest(ctx context.Context, client Client, applicationID string, request *applicationRequest, method string) (*applicationResponse, *fusionauth.Errors, error) {
+	var resp applicationResponse
+	var errors fusionauth.Errors
+
+	restClient := client.FAClient.Start(&resp, &errors).
+		WithUri("/api/application").
+		WithUriSegment(applicationID).
+		WithMethod(method)
+	if request != nil {
+		restClient.WithJSONBody(request)
+	}
+
+	err := restClient.Do(ctx)
+	if restClient.ErrorRef == nil {
+		return &resp, nil, err
+	}
+	return &resp, &errors, err
+}
@@ -6,7 +6,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func buildApplication(data *schema.ResourceData) fusionauth.Application {
+// application is an application as sent to and returned by the application
+// API. The go-client does not model the OAuth scope settings of FusionAuth
+// 1.50.0 and later, so its OAuth configuration is replaced.
+type application struct {
+	fusionauth.Application
+	OauthConfiguration applicationOAuthConfiguration `json:"oauthConfiguration,omitempty"`
+}
+
+type applicationOAuthConfiguration struct {
+	fusionauth.OAuth2Configuration
+	ConsentMode         string `json:"consentMode,omitempty"`
+	ScopeHandlingPolicy string `json:"scopeHandlingPolicy,omitempty"`
+	UnknownScopePolicy  string `json:"unknownScopePolicy,omitempty"`
+}
+
+func buildApplication(data *schema.ResourceData) application {
 	a := fusionauth.Application{
 		TenantId: data.Get("tenant_id").(string),
 		AuthenticationTokenConfiguration: fusionauth.AuthenticationTokenConfiguration{
@@ -152,7 +167,15 @@ func buildApplication(data *schema.ResourceData) fusionauth.Application {
 		},
 	}
 
-	return a
+	return application{
+		Application: a,
+		OauthConfiguration: applicationOAuthConfiguration{
+			OAuth2Configuration: a.OauthConfiguration,
+			ConsentMode:         data.Get("oauth_configuration.0.consent_mode").(string),
+			ScopeHandlingPolicy: data.Get("oauth_configuration.0.scope_handling_policy").(string),
+			UnknownScopePolicy:  data.Get("oauth_configuration.0.unknown_scope_policy").(string),
+		},
+	}
 }
 
 func buildGrants(key string, data *schema.ResourceData) []fusionauth.GrantType {
@@ -177,7 +200,7 @@ func buildRequireable(key string, data *schema.ResourceData) fusionauth.Requirab
 	}
 }
 
-func buildResourceDataFromApplication(a fusionauth.Application, data *schema.ResourceData) diag.Diagnostics {
+func buildResourceDataFromApplication(a application, data *schema.ResourceData) diag.Diagnostics {
 	if err := data.Set("tenant_id", a.TenantId); err != nil {
 		return diag.Errorf("application.tenant_id: %s", err.Error())
 	}
@@ -283,6 +306,7 @@ func buildResourceDataFromApplication(a fusionauth.Application, data *schema.Res
 			"client_authentication_policy":       a.OauthConfiguration.ClientAuthenticationPolicy,
 			"client_secret":                      a.OauthConfiguration.ClientSecret,
 			"client_id":                          a.OauthConfiguration.ClientId,
+			"consent_mode":                       a.OauthConfiguration.ConsentMode,
 			"debug":                              a.OauthConfiguration.Debug,
 			"device_verification_url":            a.OauthConfiguration.DeviceVerificationURL,
 			"generate_refresh_tokens":            a.OauthConfiguration.GenerateRefreshTokens,
@@ -292,6 +316,8 @@ func buildResourceDataFromApplication(a fusionauth.Application, data *schema.Res
 			"enabled_grants":                     a.OauthConfiguration.EnabledGrants,
 			"require_registration":               a.OauthConfiguration.RequireRegistration,
 			"proof_key_for_code_exchange_policy": a.OauthConfiguration.ProofKeyForCodeExchangePolicy,
+			"scope_handling_policy":              a.OauthConfiguration.ScopeHandlingPolicy,
+			"unknown_scope_policy":               a.OauthConfiguration.UnknownScopePolicy,
 		},
 	})
 	if err != nil {
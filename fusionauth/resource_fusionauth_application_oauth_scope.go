@@ -0,0 +1,248 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func newApplicationOAuthScope() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages a custom OAuth scope of an application. Requires FusionAuth 1.50.0 or later.",
+		CreateContext: createApplicationOAuthScope,
+		ReadContext:   readApplicationOAuthScope,
+		UpdateContext: updateApplicationOAuthScope,
+		DeleteContext: deleteApplicationOAuthScope,
+		CustomizeDiff: customizeDiffResourceMinVersion("fusionauth_application_oauth_scope", "1.50.0"),
+		Importer: &schema.ResourceImporter{
+			StateContext: importApplicationOAuthScope,
+		},
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the application that this scope is for.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "An object that can hold any information about the OAuth Scope that should be persisted.",
+			},
+			"default_consent_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The default message to display on the OAuth consent screen if one cannot be found in the theme.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A description of the OAuth Scope. This is used for display purposes only.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the OAuth Scope. This is the value that will be used to request the scope in OAuth workflows.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"required": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if the OAuth Scope is required when requested in an OAuth workflow.",
+			},
+			"scope_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The Id to use for the new OAuth Scope. If not specified a secure random UUID will be generated.",
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+// applicationOAuthScope is a custom OAuth scope of an application, which the
+// go-client does not model.
+type applicationOAuthScope struct {
+	ApplicationId         string                 `json:"applicationId,omitempty"`
+	Data                  map[string]interface{} `json:"data,omitempty"`
+	DefaultConsentMessage string                 `json:"defaultConsentMessage,omitempty"`
+	Description           string                 `json:"description,omitempty"`
+	Id                    string                 `json:"id,omitempty"`
+	Name                  string                 `json:"name,omitempty"`
+	Required              bool                   `json:"required"`
+}
+
+// applicationOAuthScopeRequest is the request body of the OAuth scope API.
+type applicationOAuthScopeRequest struct {
+	Scope applicationOAuthScope `json:"scope"`
+}
+
+type applicationOAuthScopeResponse struct {
+	fusionauth.BaseHTTPResponse
+	Scope applicationOAuthScope `json:"scope"`
+}
+
+func (r *applicationOAuthScopeResponse) SetStatus(status int) {
+	r.StatusCode = status
+}
+
+func buildApplicationOAuthScope(data *schema.ResourceData) applicationOAuthScope {
+	return applicationOAuthScope{
+		Data:                  data.Get("data").(map[string]interface{}),
+		DefaultConsentMessage: data.Get("default_consent_message").(string),
+		Description:           data.Get("description").(string),
+		Name:                  data.Get("name").(string),
+		Required:              data.Get("required").(bool),
+	}
+}
+
+// importApplicationOAuthScope imports a scope by
+// "<application_id>:<scope_id>", as scopes are retrieved through their
+// application.
+func importApplicationOAuthScope(_ context.Context, data *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(data.Id(), ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <application_id>:<scope_id>", data.Id())
+	}
+
+	if err := data.Set("application_id", parts[0]); err != nil {
+		return nil, fmt.Errorf("application_oauth_scope.application_id: %s", err.Error())
+	}
+	data.SetId(parts[1])
+
+	return []*schema.ResourceData{data}, nil
+}
+
+func createApplicationOAuthScope(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, faErrs, err := makeApplicationOAuthScopeRequest(
+		ctx, client, data.Get("application_id").(string), data.Get("scope_id").(string),
+		&applicationOAuthScopeRequest{Scope: buildApplicationOAuthScope(data)}, http.MethodPost,
+	)
+	if err != nil {
+		return diag.Errorf("CreateOAuthScope err: %v", err)
+	}
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	data.SetId(resp.Scope.Id)
+	return buildResourceDataFromApplicationOAuthScope(resp.Scope, data)
+}
+
+func readApplicationOAuthScope(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, faErrs, err := makeApplicationOAuthScopeRequest(
+		ctx, client, data.Get("application_id").(string), data.Id(), nil, http.MethodGet,
+	)
+	if err != nil {
+		return diag.Errorf("RetrieveOAuthScope err: %v", err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		data.SetId("")
+		return nil
+	}
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	return buildResourceDataFromApplicationOAuthScope(resp.Scope, data)
+}
+
+func updateApplicationOAuthScope(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, faErrs, err := makeApplicationOAuthScopeRequest(
+		ctx, client, data.Get("application_id").(string), data.Id(),
+		&applicationOAuthScopeRequest{Scope: buildApplicationOAuthScope(data)}, http.MethodPut,
+	)
+	if err != nil {
+		return diag.Errorf("UpdateOAuthScope err: %v", err)
+	}
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	return buildResourceDataFromApplicationOAuthScope(resp.Scope, data)
+}
+
+func deleteApplicationOAuthScope(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	// The delete response has no body, so it is not decoded.
+	var resp fusionauth.BaseHTTPResponse
+	var faErrs fusionauth.Errors
+	restClient := client.FAClient.Start(&resp, &faErrs)
+	err := restClient.WithUri("/api/application").
+		WithUriSegment(data.Get("application_id").(string)).
+		WithUriSegment("scope").
+		WithUriSegment(data.Id()).
+		WithMethod(http.MethodDelete).
+		Do(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	return checkResponseDiagnostics(data, resp.StatusCode, &faErrs)
+}
+
+func buildResourceDataFromApplicationOAuthScope(s applicationOAuthScope, data *schema.ResourceData) diag.Diagnostics {
+	if err := data.Set("data", s.Data); err != nil {
+		return diag.Errorf("application_oauth_scope.data: %s", err.Error())
+	}
+	if err := data.Set("default_consent_message", s.DefaultConsentMessage); err != nil {
+		return diag.Errorf("application_oauth_scope.default_consent_message: %s", err.Error())
+	}
+	if err := data.Set("description", s.Description); err != nil {
+		return diag.Errorf("application_oauth_scope.description: %s", err.Error())
+	}
+	if err := data.Set("name", s.Name); err != nil {
+		return diag.Errorf("application_oauth_scope.name: %s", err.Error())
+	}
+	if err := data.Set("required", s.Required); err != nil {
+		return diag.Errorf("application_oauth_scope.required: %s", err.Error())
+	}
+	if err := data.Set("scope_id", s.Id); err != nil {
+		return diag.Errorf("application_oauth_scope.scope_id: %s", err.Error())
+	}
+
+	return nil
+}
+
+// makeApplicationOAuthScopeRequest sends a request to the OAuth scope API of
+// an application.
+func makeApplicationOAuthScopeRequest(ctx context.Context, client Client, applicationID, scopeID string, request *applicationOAuthScopeRequest, method string) (*applicationOAuthScopeResponse, *fusionauth.Errors, error) {
+	var resp applicationOAuthScopeResponse
+	var errors fusionauth.Errors
+
+	restClient := client.FAClient.Start(&resp, &errors).
+		WithUri("/api/application").
+		WithUriSegment(applicationID).
+		WithUriSegment("scope").
+		WithUriSegment(scopeID).
+		WithMethod(method)
+	if request != nil {
+		restClient.WithJSONBody(request)
+	}
+
+	err := restClient.Do(ctx)
+	if restClient.ErrorRef == nil {
+		return &resp, nil, err
+	}
+	return &resp, &errors, err
+}
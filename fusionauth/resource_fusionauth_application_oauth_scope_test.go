@@ -0,0 +1,189 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccApplicationOAuthScope(t *testing.T) {
+	resourceName := randString10()
+	tfResourcePath := fmt.Sprintf("fusionauth_application_oauth_scope.test_%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckApplicationOAuthScopeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationOAuthScopeConfig(resourceName, "Read your orders"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfResourcePath, "name", "orders:read"),
+					resource.TestCheckResourceAttr(tfResourcePath, "description", "Read your orders"),
+					resource.TestCheckResourceAttr(tfResourcePath, "required", "false"),
+					resource.TestCheckResourceAttrPair(tfResourcePath, "application_id", "fusionauth_application.test_"+resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccApplicationOAuthScopeConfig(resourceName, "Read your order history"),
+				Check:  resource.TestCheckResourceAttr(tfResourcePath, "description", "Read your order history"),
+			},
+			{
+				Config:             testAccApplicationOAuthScopeConfig(resourceName, "Read your order history"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:      tfResourcePath,
+				ImportState:       true,
+				ImportStateIdFunc: testAccApplicationOAuthScopeImportID(tfResourcePath),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccApplicationOAuthScopeConfig(resourceName, description string) string {
+	return testAccUserResourceConfigBase(resourceName) + fmt.Sprintf(`
+	resource "fusionauth_application" "test_%[1]s" {
+		name      = "OAuth scopes %[1]s"
+		tenant_id = fusionauth_tenant.test_%[1]s.id
+	}
+
+	resource "fusionauth_application_oauth_scope" "test_%[1]s" {
+		application_id          = fusionauth_application.test_%[1]s.id
+		default_consent_message = "Allow access to your orders"
+		description             = "%[2]s"
+		name                    = "orders:read"
+	}
+	`, resourceName, description)
+}
+
+// testAccApplicationOAuthScopeImportID returns the import ID,
+// "<application_id>:<scope_id>", of the scope at tfResourcePath.
+func testAccApplicationOAuthScopeImportID(tfResourcePath string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[tfResourcePath]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", tfResourcePath)
+		}
+
+		return rs.Primary.Attributes["application_id"] + ":" + rs.Primary.ID, nil
+	}
+}
+
+// testAccCheckApplicationOAuthScopeDestroy checks that no scope of the state
+// can still be retrieved through its application.
+func testAccCheckApplicationOAuthScopeDestroy(s *terraform.State) error {
+	client := fusionauthClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fusionauth_application_oauth_scope" {
+			continue
+		}
+
+		var resp fusionauth.BaseHTTPResponse
+		var faErrs fusionauth.Errors
+		err := client.Start(&resp, &faErrs).
+			WithUri("/api/application").
+			WithUriSegment(rs.Primary.Attributes["application_id"]).
+			WithUriSegment("scope").
+			WithUriSegment(rs.Primary.ID).
+			WithMethod(http.MethodGet).
+			Do(context.Background())
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("fusionauth_application_oauth_scope %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func Test_applicationOAuthScope_requiresFusionAuth150(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"application_id": "0f5e8c4e-3c8f-4f56-9c5a-2d0e8b1c7a11",
+		"name":           "orders:read",
+	})
+
+	if _, err := newApplicationOAuthScope().Diff(context.Background(), nil, config, Client{Version: "1.49.2"}); err == nil {
+		t.Error("expected an error planning a scope on FusionAuth 1.49.2")
+	}
+	if _, err := newApplicationOAuthScope().Diff(context.Background(), nil, config, Client{Version: "1.50.0"}); err != nil {
+		t.Errorf("unexpected error planning a scope on FusionAuth 1.50.0: %v", err)
+	}
+}
+
+func Test_application_oauthScopeSettings(t *testing.T) {
+	fake, client := newFakeClient(t)
+	ctx := context.Background()
+
+	data := newApplication().TestResourceData()
+	_ = data.Set("name", "Third-party app")
+	_ = data.Set("oauth_configuration", []interface{}{map[string]interface{}{
+		"client_secret":         "third-party",
+		"consent_mode":          "RememberDecision",
+		"scope_handling_policy": "Strict",
+		"unknown_scope_policy":  "Remove",
+	}})
+
+	if diags := createApplication(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+
+	oauth, _ := fake.collection("application")[data.Id()]["oauthConfiguration"].(map[string]interface{})
+	want := map[string]string{
+		"clientSecret":        "third-party",
+		"consentMode":         "RememberDecision",
+		"scopeHandlingPolicy": "Strict",
+		"unknownScopePolicy":  "Remove",
+	}
+	for k, v := range want {
+		if oauth[k] != v {
+			t.Errorf("oauthConfiguration.%s = %v, want %q", k, oauth[k], v)
+		}
+	}
+
+	if diags := readApplication(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if got := data.Get("oauth_configuration.0.consent_mode"); got != "RememberDecision" {
+		t.Errorf("oauth_configuration.0.consent_mode = %q", got)
+	}
+}
+
+func Test_importApplicationOAuthScope(t *testing.T) {
+	tests := []struct {
+		id      string
+		wantErr bool
+	}{
+		{id: "app:scope"},
+		{id: "app", wantErr: true},
+		{id: ":scope", wantErr: true},
+		{id: "app:", wantErr: true},
+	}
+	for _, tt := range tests {
+		data := newApplicationOAuthScope().TestResourceData()
+		data.SetId(tt.id)
+
+		_, err := importApplicationOAuthScope(context.Background(), data, nil)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("importApplicationOAuthScope(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		if got := data.Get("application_id").(string); got != "app" {
+			t.Errorf("importApplicationOAuthScope(%q) application_id = %q, want %q", tt.id, got, "app")
+		}
+		if data.Id() != "scope" {
+			t.Errorf("importApplicationOAuthScope(%q) id = %q, want %q", tt.id, data.Id(), "scope")
+		}
+	}
+}
@@ -2,7 +2,9 @@ package fusionauth
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -46,7 +48,7 @@ func newApplicationRole() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importApplicationRole,
 		},
 	}
 }
@@ -60,34 +62,50 @@ func buildApplicationRole(data *schema.ResourceData) fusionauth.ApplicationRole
 	}
 }
 
-func createApplicationRole(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+// importApplicationRole imports a role by "<application_id>:<role_id>", as
+// roles can only be retrieved through their application.
+func importApplicationRole(_ context.Context, data *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(data.Id(), ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <application_id>:<role_id>", data.Id())
+	}
+
+	if err := data.Set("application_id", parts[0]); err != nil {
+		return nil, fmt.Errorf("applicationRole.application_id: %s", err.Error())
+	}
+	data.SetId(parts[1])
+
+	return []*schema.ResourceData{data}, nil
+}
+
+func createApplicationRole(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
 	ar := buildApplicationRole(data)
 	aid := data.Get("application_id").(string)
 
-	resp, faErrs, err := client.FAClient.CreateApplicationRole(
-		aid, "", fusionauth.ApplicationRequest{Role: ar},
+	resp, faErrs, err := client.FAClient.CreateApplicationRoleWithContext(
+		ctx, aid, "", fusionauth.ApplicationRequest{Role: ar},
 	)
 
 	if err != nil {
 		return diag.Errorf("CreateApplicationRole errors: %v", err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return applicationRoleToData(data, aid, resp)
 }
 
-func readApplicationRole(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func readApplicationRole(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
 
 	// application roles are only returned via an application, so we need
 	// to grab the application and drill down into the linked roles.
 	appID := data.Get("application_id").(string)
-	resp, err := client.FAClient.RetrieveApplication(appID)
+	resp, err := client.FAClient.RetrieveApplicationWithContext(ctx, appID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -124,14 +142,14 @@ func readApplicationRole(_ context.Context, data *schema.ResourceData, i interfa
 	return diags
 }
 
-func updateApplicationRole(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateApplicationRole(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
 	ar := buildApplicationRole(data)
 	aid := data.Get("application_id").(string)
 
-	resp, faErrs, err := client.FAClient.UpdateApplicationRole(
-		aid, data.Id(), fusionauth.ApplicationRequest{Role: ar},
+	resp, faErrs, err := client.FAClient.UpdateApplicationRoleWithContext(
+		ctx, aid, data.Id(), fusionauth.ApplicationRequest{Role: ar},
 	)
 
 	if err != nil {
@@ -142,25 +160,25 @@ func updateApplicationRole(_ context.Context, data *schema.ResourceData, i inter
 		data.SetId("")
 		return nil
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return applicationRoleToData(data, aid, resp)
 }
 
-func deleteApplicationRole(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteApplicationRole(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
 	id := data.Id()
 	aid := data.Get("application_id").(string)
 
-	resp, faErrs, err := client.FAClient.DeleteApplicationRole(aid, id)
+	resp, faErrs, err := client.FAClient.DeleteApplicationRoleWithContext(ctx, aid, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err = checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
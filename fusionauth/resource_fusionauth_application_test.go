@@ -0,0 +1,45 @@
+package fusionauth
+
+import (
+	"context"
+	"testing"
+)
+
+func Test_application_otherTenant(t *testing.T) {
+	fake, client := newFakeClient(t)
+	ctx := context.Background()
+
+	providerTenantID := fake.create("tenant", map[string]interface{}{"name": "Default"})
+	tenantID := fake.create("tenant", map[string]interface{}{"name": "Other"})
+	applicationID := fake.create("application", map[string]interface{}{"name": "My App", "tenantId": tenantID})
+	client.FAClient.TenantId = providerTenantID
+
+	data := newApplication().TestResourceData()
+	data.SetId(applicationID)
+	_ = data.Set("tenant_id", tenantID)
+
+	if diags := readApplication(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if data.Id() != applicationID {
+		t.Fatal("expected the application of another tenant than the provider's to be read")
+	}
+	if got := data.Get("name"); got != "My App" {
+		t.Errorf("name = %q", got)
+	}
+
+	_ = data.Set("name", "Our App")
+	if diags := updateApplication(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if got := fake.collection("application")[applicationID]["name"]; got != "Our App" {
+		t.Errorf("name = %v, want %q", got, "Our App")
+	}
+
+	if diags := deleteApplication(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if _, ok := fake.collection("application")[applicationID]; ok {
+		t.Error("expected the application of another tenant than the provider's to be deleted")
+	}
+}
@@ -0,0 +1,260 @@
+package fusionauth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The Email Plus reminder window FusionAuth uses by default.
+const (
+	defaultEmailPlusMaximumHours = 48
+	defaultEmailPlusMinimumHours = 24
+)
+
+func newConsent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createConsent,
+		ReadContext:   readConsent,
+		UpdateContext: updateConsent,
+		DeleteContext: deleteConsent,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The Id to use for the new Consent. If not specified a secure random UUID will be generated.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"consent_email_template_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The Id of the Email Template that is used to send confirmation to the end user.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"country_minimum_age_for_self_consent": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "This property optionally overrides the value provided in default_minimum_age_for_self_consent if a more specific value is defined. This can be useful when the age of self consent varies by country. The keys are ISO 3166-1 alpha-2 country codes.",
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "An object that can hold any information about the Consent that should be persisted.",
+			},
+			"default_minimum_age_for_self_consent": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The default age of self consent used when granting this consent to a user unless a more specific one is provided by the country_minimum_age_for_self_consent. A user that meets the minimum age of self consent may self-consent, this means the recipient may also be the giver.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"email_plus": {
+				Type:       schema.TypeList,
+				MaxItems:   1,
+				Optional:   true,
+				Computed:   true,
+				ConfigMode: schema.SchemaConfigModeAttr,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email_template_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The Id of the Email Template that is used to send the reminder emails to the consent giver. This value is required when enabled is set to true.",
+							ValidateFunc: validation.IsUUID,
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Set this value to true to enable the Email Plus workflow. Email Plus provides and additional opportunity to notify the giver that consent was provided. For example, if consentEmailTemplateId is provided then when the consent is granted an email will be sent to notify the giver that consent was granted to the user. When using Email Plus a follow up email will be sent to the giver at a randomly selected time within the configured minimum and maximum range of hours.",
+						},
+						"maximum_time_to_send_email_in_hours": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      defaultEmailPlusMaximumHours,
+							Description:  "The maximum number of hours to wait until sending the reminder notification after the initial consent was granted.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"minimum_time_to_send_email_in_hours": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      defaultEmailPlusMinimumHours,
+							Description:  "The minimum number of hours to wait until sending the reminder notification after the initial consent was granted.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"multiple_values_allowed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Set this value to true if more than one value may be used when granting this consent to a User. This value is not used when no values have been defined for this consent.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The unique name of the consent.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"values": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "One or more values that may be assigned for this consent.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func buildConsent(data *schema.ResourceData) fusionauth.Consent {
+	c := fusionauth.Consent{
+		ConsentEmailTemplateId:          data.Get("consent_email_template_id").(string),
+		Data:                            data.Get("data").(map[string]interface{}),
+		DefaultMinimumAgeForSelfConsent: data.Get("default_minimum_age_for_self_consent").(int),
+		Id:                              data.Get("id").(string),
+		MultipleValuesAllowed:           data.Get("multiple_values_allowed").(bool),
+		Name:                            data.Get("name").(string),
+	}
+
+	// The defaults of the email_plus attributes only apply when the block is
+	// configured.
+	c.EmailPlus = fusionauth.EmailPlus{
+		MaximumTimeToSendEmailInHours: defaultEmailPlusMaximumHours,
+		MinimumTimeToSendEmailInHours: defaultEmailPlusMinimumHours,
+	}
+	if len(data.Get("email_plus").([]interface{})) > 0 {
+		c.EmailPlus = fusionauth.EmailPlus{
+			Enableable: fusionauth.Enableable{
+				Enabled: data.Get("email_plus.0.enabled").(bool),
+			},
+			EmailTemplateId:               data.Get("email_plus.0.email_template_id").(string),
+			MaximumTimeToSendEmailInHours: data.Get("email_plus.0.maximum_time_to_send_email_in_hours").(int),
+			MinimumTimeToSendEmailInHours: data.Get("email_plus.0.minimum_time_to_send_email_in_hours").(int),
+		}
+	}
+
+	if i, ok := data.GetOk("country_minimum_age_for_self_consent"); ok {
+		c.CountryMinimumAgeForSelfConsent = make(map[string]int)
+		for country, age := range i.(map[string]interface{}) {
+			c.CountryMinimumAgeForSelfConsent[country] = age.(int)
+		}
+	}
+
+	for _, v := range data.Get("values").([]interface{}) {
+		c.Values = append(c.Values, v.(string))
+	}
+
+	return c
+}
+
+func createConsent(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	c := buildConsent(data)
+
+	resp, faErrs, err := client.FAClient.CreateConsentWithContext(ctx, c.Id, fusionauth.ConsentRequest{
+		Consent: c,
+	})
+	if err != nil {
+		return diag.Errorf("CreateConsent err: %v", err)
+	}
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	data.SetId(resp.Consent.Id)
+	return nil
+}
+
+func readConsent(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, err := client.FAClient.RetrieveConsentWithContext(ctx, data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		data.SetId("")
+		return nil
+	}
+	if err := checkResponse(resp.StatusCode, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	c := resp.Consent
+	if err := data.Set("consent_email_template_id", c.ConsentEmailTemplateId); err != nil {
+		return diag.Errorf("consent.consent_email_template_id: %s", err.Error())
+	}
+	if err := data.Set("country_minimum_age_for_self_consent", c.CountryMinimumAgeForSelfConsent); err != nil {
+		return diag.Errorf("consent.country_minimum_age_for_self_consent: %s", err.Error())
+	}
+	if err := data.Set("data", c.Data); err != nil {
+		return diag.Errorf("consent.data: %s", err.Error())
+	}
+	if err := data.Set("default_minimum_age_for_self_consent", c.DefaultMinimumAgeForSelfConsent); err != nil {
+		return diag.Errorf("consent.default_minimum_age_for_self_consent: %s", err.Error())
+	}
+
+	emailPlus := []map[string]interface{}{{
+		"email_template_id":                   c.EmailPlus.EmailTemplateId,
+		"enabled":                             c.EmailPlus.Enabled,
+		"maximum_time_to_send_email_in_hours": c.EmailPlus.MaximumTimeToSendEmailInHours,
+		"minimum_time_to_send_email_in_hours": c.EmailPlus.MinimumTimeToSendEmailInHours,
+	}}
+	if err := data.Set("email_plus", emailPlus); err != nil {
+		return diag.Errorf("consent.email_plus: %s", err.Error())
+	}
+	if err := data.Set("multiple_values_allowed", c.MultipleValuesAllowed); err != nil {
+		return diag.Errorf("consent.multiple_values_allowed: %s", err.Error())
+	}
+	if err := data.Set("name", c.Name); err != nil {
+		return diag.Errorf("consent.name: %s", err.Error())
+	}
+	if err := data.Set("values", c.Values); err != nil {
+		return diag.Errorf("consent.values: %s", err.Error())
+	}
+
+	return nil
+}
+
+func updateConsent(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	c := buildConsent(data)
+
+	resp, faErrs, err := client.FAClient.UpdateConsentWithContext(ctx, data.Id(), fusionauth.ConsentRequest{
+		Consent: c,
+	})
+	if err != nil {
+		return diag.Errorf("UpdateConsent err: %v", err)
+	}
+
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	return nil
+}
+
+func deleteConsent(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, faErrs, err := client.FAClient.DeleteConsentWithContext(ctx, data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	return nil
+}
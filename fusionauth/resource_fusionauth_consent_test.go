@@ -0,0 +1,75 @@
+package fusionauth
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccConsent(t *testing.T) {
+	resourceName := randString10()
+	tfResourcePath := fmt.Sprintf("fusionauth_consent.test_%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("fusionauth_consent", "/api/consent"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConsentConfig(resourceName, 13),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfResourcePath, "name", "COPPA "+resourceName),
+					resource.TestCheckResourceAttr(tfResourcePath, "default_minimum_age_for_self_consent", "13"),
+					resource.TestCheckResourceAttr(tfResourcePath, "country_minimum_age_for_self_consent.de", "16"),
+					resource.TestCheckResourceAttr(tfResourcePath, "email_plus.0.enabled", "false"),
+					resource.TestCheckResourceAttr(tfResourcePath, "values.#", "2"),
+				),
+			},
+			{
+				Config: testAccConsentConfig(resourceName, 14),
+				Check:  resource.TestCheckResourceAttr(tfResourcePath, "default_minimum_age_for_self_consent", "14"),
+			},
+			{
+				Config:             testAccConsentConfig(resourceName, 14),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:      tfResourcePath,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccConsentConfig(resourceName string, minimumAge int) string {
+	return fmt.Sprintf(`
+	resource "fusionauth_consent" "test_%[1]s" {
+		name                                 = "COPPA %[1]s"
+		default_minimum_age_for_self_consent = %[2]d
+		country_minimum_age_for_self_consent = {
+			"de" = 16
+		}
+		multiple_values_allowed = true
+		values                  = ["email", "sms"]
+	}
+	`, resourceName, minimumAge)
+}
+
+func Test_consent_emailPlusDefaults(t *testing.T) {
+	fake, client := newFakeClient(t)
+
+	data := applyConfig(t, newConsent(), map[string]interface{}{
+		"name":                                 "COPPA",
+		"default_minimum_age_for_self_consent": 13,
+	}, client)
+
+	emailPlus := fake.collection("consent")[data.Id()]["emailPlus"].(map[string]interface{})
+	if emailPlus["maximumTimeToSendEmailInHours"] != float64(48) || emailPlus["minimumTimeToSendEmailInHours"] != float64(24) {
+		t.Errorf("unexpected email plus settings %#v", emailPlus)
+	}
+	if got := data.Get("email_plus.0.maximum_time_to_send_email_in_hours"); got != 48 {
+		t.Errorf("email_plus.0.maximum_time_to_send_email_in_hours = %v, want 48", got)
+	}
+}
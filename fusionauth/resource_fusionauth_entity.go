@@ -71,36 +71,33 @@ func resourceEntity() *schema.Resource {
 	}
 }
 
-func createEntity(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func createEntity(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	resourceReq, diags := dataToEntityRequest(data)
 	if diags != nil {
 		return diags
 	}
 
 	client := i.(Client)
-	oldTenantID := client.FAClient.TenantId
-	client.FAClient.TenantId = resourceReq.Entity.TenantId
-	defer func() {
-		client.FAClient.TenantId = oldTenantID
-	}()
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
 
-	res, faErrs, err := client.FAClient.CreateEntity(resourceReq.Entity.Id, resourceReq)
+	res, faErrs, err := client.FAClient.CreateEntityWithContext(ctx, resourceReq.Entity.Id, resourceReq)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err = checkResponse(res.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, res.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return entityResponseToData(data, res)
 }
 
-func readEntity(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func readEntity(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
 	revertTid := clientTenantIDOverride(&client, data)
 	defer revertTid()
 
-	res, faErrs, err := client.FAClient.RetrieveEntity(data.Id())
+	res, faErrs, err := client.FAClient.RetrieveEntityWithContext(ctx, data.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -109,14 +106,14 @@ func readEntity(_ context.Context, data *schema.ResourceData, i interface{}) (di
 		data.SetId("")
 		return nil
 	}
-	if err = checkResponse(res.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, res.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return entityResponseToData(data, res)
 }
 
-func updateEntity(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func updateEntity(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
 	revertTid := clientTenantIDOverride(&client, data)
 	defer revertTid()
@@ -126,23 +123,23 @@ func updateEntity(_ context.Context, data *schema.ResourceData, i interface{}) (
 		return diags
 	}
 
-	res, faErrs, err := client.FAClient.UpdateEntity(data.Id(), req)
+	res, faErrs, err := client.FAClient.UpdateEntityWithContext(ctx, data.Id(), req)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err = checkResponse(res.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, res.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return entityResponseToData(data, res)
 }
 
-func deleteEntity(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func deleteEntity(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
 	revertTid := clientTenantIDOverride(&client, data)
 	defer revertTid()
 
-	res, faErrs, err := client.FAClient.DeleteEntity(data.Id())
+	res, faErrs, err := client.FAClient.DeleteEntityWithContext(ctx, data.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -153,8 +150,8 @@ func deleteEntity(_ context.Context, data *schema.ResourceData, i interface{}) (
 		return nil
 	}
 
-	if err = checkResponse(res.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, res.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return diags
@@ -94,12 +94,12 @@ func createEntityGrant(ctx context.Context, data *schema.ResourceData, i interfa
 	}
 
 	entityID := data.Get("entity_id").(string)
-	res, faErrs, err := client.FAClient.UpsertEntityGrant(entityID, resourceReq)
+	res, faErrs, err := client.FAClient.UpsertEntityGrantWithContext(ctx, entityID, resourceReq)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err = checkResponse(res.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, res.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	data.SetId(syntheticGrantID(data))
@@ -117,7 +117,7 @@ func syntheticGrantID(data *schema.ResourceData) string {
 	return fmt.Sprintf("%s_%s", entityID, userID)
 }
 
-func readEntityGrant(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func readEntityGrant(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
 
 	if iTenantID, ok := data.GetOk("tenant_id"); ok {
@@ -133,7 +133,7 @@ func readEntityGrant(_ context.Context, data *schema.ResourceData, i interface{}
 	recipientEntityID := data.Get("recipient_entity_id").(string)
 	userID := data.Get("user_id").(string)
 
-	res, faErrs, err := client.FAClient.RetrieveEntityGrant(entityID, recipientEntityID, userID)
+	res, faErrs, err := client.FAClient.RetrieveEntityGrantWithContext(ctx, entityID, recipientEntityID, userID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -144,8 +144,8 @@ func readEntityGrant(_ context.Context, data *schema.ResourceData, i interface{}
 		data.SetId("")
 		return nil
 	}
-	if err = checkResponse(res.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, res.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return entityGrantResponseToData(data, res)
@@ -169,12 +169,12 @@ func updateEntityGrant(ctx context.Context, data *schema.ResourceData, i interfa
 	}
 
 	entityID := data.Get("entity_id").(string)
-	res, faErrs, err := client.FAClient.UpsertEntityGrant(entityID, resourceReq)
+	res, faErrs, err := client.FAClient.UpsertEntityGrantWithContext(ctx, entityID, resourceReq)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err = checkResponse(res.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, res.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	// The entity grant api doesn't return a payload on POST/PUT, so we have to
@@ -182,14 +182,23 @@ func updateEntityGrant(ctx context.Context, data *schema.ResourceData, i interfa
 	return readEntityGrant(ctx, data, i)
 }
 
-func deleteEntityGrant(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func deleteEntityGrant(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
 
+	if iTenantID, ok := data.GetOk("tenant_id"); ok {
+		// Inject Tenant ID if specified...
+		oldTenantID := client.FAClient.TenantId
+		client.FAClient.TenantId = iTenantID.(string)
+		defer func() {
+			client.FAClient.TenantId = oldTenantID
+		}()
+	}
+
 	entityID := data.Get("entity_id").(string)
 	recipientEntityID := data.Get("recipient_entity_id").(string)
 	userID := data.Get("user_id").(string)
 
-	resp, faErrs, err := client.FAClient.DeleteEntityGrant(entityID, recipientEntityID, userID)
+	resp, faErrs, err := client.FAClient.DeleteEntityGrantWithContext(ctx, entityID, recipientEntityID, userID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -200,8 +209,8 @@ func deleteEntityGrant(_ context.Context, data *schema.ResourceData, i interface
 		return nil
 	}
 
-	if err = checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return diags
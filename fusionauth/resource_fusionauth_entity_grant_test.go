@@ -0,0 +1,36 @@
+package fusionauth
+
+import (
+	"context"
+	"testing"
+)
+
+func Test_entityGrant_otherTenant(t *testing.T) {
+	fake, client := newFakeClient(t)
+	ctx := context.Background()
+
+	providerTenantID := fake.create("tenant", map[string]interface{}{"name": "Default"})
+	tenantID := fake.create("tenant", map[string]interface{}{"name": "Other"})
+	entityID := fake.create("entity", map[string]interface{}{"name": "Printer", "tenantId": tenantID})
+	userID := fake.create("user", map[string]interface{}{"tenantId": tenantID})
+	client.FAClient.TenantId = providerTenantID
+
+	data := resourceEntityGrant().TestResourceData()
+	_ = data.Set("entity_id", entityID)
+	_ = data.Set("tenant_id", tenantID)
+	_ = data.Set("user_id", userID)
+
+	if diags := createEntityGrant(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if len(fake.grants[entityID]) != 1 {
+		t.Fatal("expected the grant of an entity of another tenant than the provider's to be created")
+	}
+
+	if diags := deleteEntityGrant(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if len(fake.grants[entityID]) != 0 {
+		t.Error("expected the grant of an entity of another tenant than the provider's to be deleted")
+	}
+}
@@ -77,27 +77,27 @@ func resourceEntityType() *schema.Resource {
 	}
 }
 
-func createEntityType(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func createEntityType(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	req, diags := dataToEntityTypeRequest(data)
 	if diags != nil {
 		return diags
 	}
 
 	client := i.(Client)
-	res, faErrs, err := client.FAClient.CreateEntityType(req.EntityType.Id, req)
+	res, faErrs, err := client.FAClient.CreateEntityTypeWithContext(ctx, req.EntityType.Id, req)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err = checkResponse(res.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, res.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return entityTypeResponseToData(data, res)
 }
 
-func readEntityType(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func readEntityType(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
-	res, faErrs, err := client.FAClient.RetrieveEntityType(data.Id())
+	res, faErrs, err := client.FAClient.RetrieveEntityTypeWithContext(ctx, data.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -106,36 +106,36 @@ func readEntityType(_ context.Context, data *schema.ResourceData, i interface{})
 		data.SetId("")
 		return nil
 	}
-	if err = checkResponse(res.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, res.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return entityTypeResponseToData(data, res)
 }
 
-func updateEntityType(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func updateEntityType(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
 	req, diags := dataToEntityTypeRequest(data)
 	if diags != nil {
 		return diags
 	}
 
-	resp, faErrs, err := client.FAClient.UpdateEntityType(data.Id(), req)
+	resp, faErrs, err := client.FAClient.UpdateEntityTypeWithContext(ctx, data.Id(), req)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err = checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return entityTypeResponseToData(data, resp)
 }
 
-func deleteEntityType(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func deleteEntityType(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
 
 	resourceID := data.Id()
-	resp, faErrs, err := client.FAClient.DeleteEntityType(resourceID)
+	resp, faErrs, err := client.FAClient.DeleteEntityTypeWithContext(ctx, resourceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -146,8 +146,8 @@ func deleteEntityType(_ context.Context, data *schema.ResourceData, i interface{
 		return nil
 	}
 
-	if err = checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return diags
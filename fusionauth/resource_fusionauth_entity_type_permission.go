@@ -64,7 +64,7 @@ func resourceEntityTypePermission() *schema.Resource {
 	}
 }
 
-func createEntityTypePermission(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func createEntityTypePermission(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	resourceReq, diags := dataToEntityTypePermissionRequest(data)
 	if diags != nil {
 		return diags
@@ -72,24 +72,24 @@ func createEntityTypePermission(_ context.Context, data *schema.ResourceData, i
 
 	client := i.(Client)
 	entityTypeID := data.Get("entity_type_id").(string)
-	res, faErrs, err := client.FAClient.CreateEntityTypePermission(entityTypeID, resourceReq.Permission.Id, resourceReq)
+	res, faErrs, err := client.FAClient.CreateEntityTypePermissionWithContext(ctx, entityTypeID, resourceReq.Permission.Id, resourceReq)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err = checkResponse(res.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, res.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return entityTypePermissionResponseToData(data, entityTypeID, res)
 }
 
-func readEntityTypePermission(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func readEntityTypePermission(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
 
 	// entity type permissions are only returned via an entity type, so we need
 	// to grab the entity type and drill down into the linked permissions.
 	entityTypeID := data.Get("entity_type_id").(string)
-	res, faErrs, err := client.FAClient.RetrieveEntityType(entityTypeID)
+	res, faErrs, err := client.FAClient.RetrieveEntityTypeWithContext(ctx, entityTypeID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -97,8 +97,8 @@ func readEntityTypePermission(_ context.Context, data *schema.ResourceData, i in
 		data.SetId("")
 		return nil
 	}
-	if err = checkResponse(res.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, res.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	// Attempt to find the linked entity type permission...
@@ -126,7 +126,7 @@ func readEntityTypePermission(_ context.Context, data *schema.ResourceData, i in
 	return diags
 }
 
-func updateEntityTypePermission(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func updateEntityTypePermission(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	req, diags := dataToEntityTypePermissionRequest(data)
 	if diags != nil {
 		return diags
@@ -134,23 +134,23 @@ func updateEntityTypePermission(_ context.Context, data *schema.ResourceData, i
 
 	client := i.(Client)
 	entityTypeID := data.Get("entity_type_id").(string)
-	res, faErrs, err := client.FAClient.UpdateEntityTypePermission(entityTypeID, data.Id(), req)
+	res, faErrs, err := client.FAClient.UpdateEntityTypePermissionWithContext(ctx, entityTypeID, data.Id(), req)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err = checkResponse(res.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, res.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return entityTypePermissionResponseToData(data, entityTypeID, res)
 }
 
-func deleteEntityTypePermission(_ context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
+func deleteEntityTypePermission(ctx context.Context, data *schema.ResourceData, i interface{}) (diags diag.Diagnostics) {
 	client := i.(Client)
 
 	resourceID := data.Id()
 	entityTypeID := data.Get("entity_type_id").(string)
-	res, faErrs, err := client.FAClient.DeleteEntityTypePermission(entityTypeID, resourceID)
+	res, faErrs, err := client.FAClient.DeleteEntityTypePermissionWithContext(ctx, entityTypeID, resourceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -161,8 +161,8 @@ func deleteEntityTypePermission(_ context.Context, data *schema.ResourceData, i
 		return nil
 	}
 
-	if err = checkResponse(res.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, res.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return diags
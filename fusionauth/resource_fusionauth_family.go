@@ -0,0 +1,276 @@
+package fusionauth
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func newFamily() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages a family and all of its members. Members added outside Terraform are removed from the family.",
+		CreateContext: createFamily,
+		ReadContext:   readFamily,
+		UpdateContext: updateFamily,
+		DeleteContext: deleteFamily,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"family_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The Id to use for the new Family. If not specified a secure random UUID will be generated.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"member": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The authoritative set of members of the Family.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"owner": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether or not the User is the owner of the Family.",
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(fusionauth.FamilyRole_Adult),
+								string(fusionauth.FamilyRole_Child),
+								string(fusionauth.FamilyRole_Teen),
+							}, false),
+							Description: "The role of the User in the Family.",
+						},
+						"user_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The Id of the User.",
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildFamilyMembers returns the members in set, owners and adults first, as
+// the first member is the one the family is created with.
+func buildFamilyMembers(set *schema.Set) []fusionauth.FamilyMember {
+	members := make([]fusionauth.FamilyMember, 0, set.Len())
+	for _, m := range set.List() {
+		member := m.(map[string]interface{})
+		members = append(members, fusionauth.FamilyMember{
+			Owner:  member["owner"].(bool),
+			Role:   fusionauth.FamilyRole(member["role"].(string)),
+			UserId: member["user_id"].(string),
+		})
+	}
+
+	sort.SliceStable(members, func(i, j int) bool {
+		return familyMemberRank(members[i]) < familyMemberRank(members[j])
+	})
+
+	return members
+}
+
+func familyMemberRank(m fusionauth.FamilyMember) int {
+	switch {
+	case m.Owner:
+		return 0
+	case m.Role == fusionauth.FamilyRole_Adult:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func createFamily(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	members := buildFamilyMembers(data.Get("member").(*schema.Set))
+
+	resp, faErrs, err := client.FAClient.CreateFamilyWithContext(ctx, data.Get("family_id").(string), fusionauth.FamilyRequest{
+		FamilyMember: members[0],
+	})
+	if err != nil {
+		return diag.Errorf("CreateFamily err: %v", err)
+	}
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	data.SetId(resp.Family.Id)
+	for _, m := range members[1:] {
+		if diags := addFamilyMember(ctx, data, client, m, false); diags != nil {
+			return diags
+		}
+	}
+
+	return readFamily(ctx, data, i)
+}
+
+func readFamily(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, err := client.FAClient.RetrieveFamilyMembersByFamilyIdWithContext(ctx, data.Id())
+	if err != nil {
+		return diag.Errorf("RetrieveFamilyMembersByFamilyId err: %v", err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		data.SetId("")
+		return nil
+	}
+	if err := checkResponse(resp.StatusCode, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := data.Set("family_id", data.Id()); err != nil {
+		return diag.Errorf("family.family_id: %s", err.Error())
+	}
+
+	m := make([]map[string]interface{}, 0, len(resp.Family.Members))
+	for _, member := range resp.Family.Members {
+		m = append(m, map[string]interface{}{
+			"owner":   member.Owner,
+			"role":    string(member.Role),
+			"user_id": member.UserId,
+		})
+	}
+	if err := data.Set("member", m); err != nil {
+		return diag.Errorf("family.member: %s", err.Error())
+	}
+
+	return nil
+}
+
+func updateFamily(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, err := client.FAClient.RetrieveFamilyMembersByFamilyIdWithContext(ctx, data.Id())
+	if err != nil {
+		return diag.Errorf("RetrieveFamilyMembersByFamilyId err: %v", err)
+	}
+	if err := checkResponse(resp.StatusCode, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	current := make(map[string]fusionauth.FamilyMember, len(resp.Family.Members))
+	for _, m := range resp.Family.Members {
+		current[m.UserId] = m
+	}
+	desired := buildFamilyMembers(data.Get("member").(*schema.Set))
+
+	// A family is deleted together with its last member, so new members are
+	// added before any are removed.
+	for _, m := range desired {
+		if _, ok := current[m.UserId]; ok {
+			continue
+		}
+		if diags := addFamilyMember(ctx, data, client, m, false); diags != nil {
+			return diags
+		}
+		current[m.UserId] = m
+	}
+
+	// FusionAuth cannot change the role or owner flag of a member, so changed
+	// members are removed and added again.
+	for _, m := range desired {
+		if prev := current[m.UserId]; prev.Role == m.Role && prev.Owner == m.Owner {
+			continue
+		}
+
+		lastMember := len(current) == 1
+		if diags := removeFamilyMember(ctx, data, client, m.UserId); diags != nil {
+			return diags
+		}
+		if diags := addFamilyMember(ctx, data, client, m, lastMember); diags != nil {
+			return diags
+		}
+		current[m.UserId] = m
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	for _, m := range desired {
+		wanted[m.UserId] = true
+	}
+	for userID := range current {
+		if wanted[userID] {
+			continue
+		}
+		if diags := removeFamilyMember(ctx, data, client, userID); diags != nil {
+			return diags
+		}
+	}
+
+	return readFamily(ctx, data, i)
+}
+
+func deleteFamily(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, err := client.FAClient.RetrieveFamilyMembersByFamilyIdWithContext(ctx, data.Id())
+	if err != nil {
+		return diag.Errorf("RetrieveFamilyMembersByFamilyId err: %v", err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err := checkResponse(resp.StatusCode, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The members of the family are authoritative, so members added since the
+	// last refresh are removed too. The family is deleted by FusionAuth once
+	// its last member is removed.
+	for _, m := range resp.Family.Members {
+		if diags := removeFamilyMember(ctx, data, client, m.UserId); diags != nil {
+			return diags
+		}
+	}
+
+	return nil
+}
+
+// addFamilyMember adds a member to the family of the resource. If create is
+// set, the family no longer exists and is created again with the member.
+func addFamilyMember(ctx context.Context, data *schema.ResourceData, client Client, m fusionauth.FamilyMember, create bool) diag.Diagnostics {
+	req := fusionauth.FamilyRequest{FamilyMember: m}
+
+	var resp *fusionauth.FamilyResponse
+	var faErrs *fusionauth.Errors
+	var err error
+	if create {
+		resp, faErrs, err = client.FAClient.CreateFamilyWithContext(ctx, data.Id(), req)
+	} else {
+		resp, faErrs, err = client.FAClient.AddUserToFamilyWithContext(ctx, data.Id(), req)
+	}
+	if err != nil {
+		return diag.Errorf("AddUserToFamily err: %v", err)
+	}
+
+	return checkResponseDiagnostics(data, resp.StatusCode, faErrs)
+}
+
+func removeFamilyMember(ctx context.Context, data *schema.ResourceData, client Client, userID string) diag.Diagnostics {
+	resp, faErrs, err := client.FAClient.RemoveUserFromFamilyWithContext(ctx, data.Id(), userID)
+	if err != nil {
+		return diag.Errorf("RemoveUserFromFamily err: %v", err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	return checkResponseDiagnostics(data, resp.StatusCode, faErrs)
+}
@@ -0,0 +1,159 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccFamily(t *testing.T) {
+	resourceName := randString10()
+	tfResourcePath := fmt.Sprintf("fusionauth_family.test_%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("fusionauth_family", "/api/user/family"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFamilyConfig(resourceName, "Child"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfResourcePath, "member.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(tfResourcePath, "member.*", map[string]string{
+						"owner": "true",
+						"role":  "Adult",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(tfResourcePath, "member.*", map[string]string{
+						"owner": "false",
+						"role":  "Child",
+					}),
+				),
+			},
+			{
+				Config: testAccFamilyConfig(resourceName, "Teen"),
+				Check: resource.TestCheckTypeSetElemNestedAttrs(tfResourcePath, "member.*", map[string]string{
+					"owner": "false",
+					"role":  "Teen",
+				}),
+			},
+			{
+				Config:             testAccFamilyConfig(resourceName, "Teen"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:      tfResourcePath,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFamilyConfig(resourceName, childRole string) string {
+	return testAccUserResourceConfigBase(resourceName) + fmt.Sprintf(`
+	resource "fusionauth_user" "parent_%[1]s" {
+		email     = "parent-%[1]s@example.com"
+		tenant_id = fusionauth_tenant.test_%[1]s.id
+	}
+
+	resource "fusionauth_user" "child_%[1]s" {
+		email     = "child-%[1]s@example.com"
+		tenant_id = fusionauth_tenant.test_%[1]s.id
+	}
+
+	resource "fusionauth_family" "test_%[1]s" {
+		member {
+			owner   = true
+			role    = "Adult"
+			user_id = fusionauth_user.parent_%[1]s.id
+		}
+		member {
+			role    = "%[2]s"
+			user_id = fusionauth_user.child_%[1]s.id
+		}
+	}
+	`, resourceName, childRole)
+}
+
+func Test_family_members(t *testing.T) {
+	fake, client := newFakeClient(t)
+	ctx := context.Background()
+
+	parent := fake.create("user", map[string]interface{}{})
+	child := fake.create("user", map[string]interface{}{})
+	outsider := fake.create("user", map[string]interface{}{})
+
+	r := newFamily()
+	data := r.TestResourceData()
+	_ = data.Set("member", []interface{}{
+		map[string]interface{}{"owner": false, "role": "Child", "user_id": child},
+		map[string]interface{}{"owner": true, "role": "Adult", "user_id": parent},
+	})
+	if diags := createFamily(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+
+	family := fake.collection("family")[data.Id()]
+	members, _ := family["members"].([]interface{})
+	if len(members) != 2 || members[0].(map[string]interface{})["userId"] != parent {
+		t.Fatalf("expected the family to be created with the owner, got %#v", members)
+	}
+
+	// Members added outside Terraform are removed, changed members are updated.
+	family["members"] = append(members, map[string]interface{}{"role": "Adult", "userId": outsider})
+	if diags := readFamily(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	update := func(members ...interface{}) {
+		t.Helper()
+		_ = data.Set("member", members)
+		if diags := updateFamily(ctx, data, client); diags.HasError() {
+			t.Fatalf("unexpected error: %#v", diags)
+		}
+	}
+	update(
+		map[string]interface{}{"owner": true, "role": "Adult", "user_id": parent},
+		map[string]interface{}{"owner": false, "role": "Teen", "user_id": child},
+	)
+	want := map[string]string{parent: "Adult", child: "Teen"}
+	if got := familyRoles(fake, data.Id()); !reflect.DeepEqual(got, want) {
+		t.Errorf("members = %v, want %v", got, want)
+	}
+
+	// Changing the only member recreates the family with the same Id.
+	update(map[string]interface{}{"owner": true, "role": "Adult", "user_id": parent})
+	update(map[string]interface{}{"owner": false, "role": "Teen", "user_id": parent})
+	want = map[string]string{parent: "Teen"}
+	if got := familyRoles(fake, data.Id()); !reflect.DeepEqual(got, want) {
+		t.Errorf("members = %v, want %v", got, want)
+	}
+	if got := data.Get("member").(*schema.Set).Len(); got != 1 {
+		t.Errorf("read %d members, want 1", got)
+	}
+
+	// Members added since the last refresh are removed on delete too.
+	family = fake.collection("family")[data.Id()]
+	family["members"] = append(family["members"].([]interface{}), map[string]interface{}{"role": "Adult", "userId": outsider})
+	if diags := deleteFamily(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if _, ok := fake.collection("family")[data.Id()]; ok {
+		t.Error("expected the family to be deleted with its last member")
+	}
+}
+
+// familyRoles returns the role of every member of a family of the fake.
+func familyRoles(fake *fakeFusionAuth, familyID string) map[string]string {
+	roles := make(map[string]string)
+	members, _ := fake.collection("family")[familyID]["members"].([]interface{})
+	for _, m := range members {
+		member := m.(map[string]interface{})
+		roles[member["userId"].(string)], _ = member["role"].(string)
+	}
+
+	return roles
+}
@@ -70,29 +70,29 @@ func resourceForm() *schema.Resource {
 	}
 }
 
-func createForm(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createForm(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	f := buildForm(data)
 	var fid string
 	if fi, ok := data.GetOk("form_id"); ok {
 		fid = fi.(string)
 	}
-	resp, faErrs, err := client.FAClient.CreateForm(fid, fusionauth.FormRequest{Form: f})
+	resp, faErrs, err := client.FAClient.CreateFormWithContext(ctx, fid, fusionauth.FormRequest{Form: f})
 	if err != nil {
 		return diag.Errorf("createForm err: %v", err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 	data.SetId(resp.Form.Id)
 	return buildResourceDataFromForm(data, resp.Form)
 }
 
-func readForm(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readForm(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, err := client.FAClient.RetrieveForm(id)
+	resp, err := client.FAClient.RetrieveFormWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -108,31 +108,31 @@ func readForm(_ context.Context, data *schema.ResourceData, i interface{}) diag.
 	return buildResourceDataFromForm(data, resp.Form)
 }
 
-func updateForm(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateForm(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	f := buildForm(data)
 
-	resp, faErrs, err := client.FAClient.UpdateForm(data.Id(), fusionauth.FormRequest{Form: f})
+	resp, faErrs, err := client.FAClient.UpdateFormWithContext(ctx, data.Id(), fusionauth.FormRequest{Form: f})
 	if err != nil {
 		return diag.Errorf("updateForm err: %v", err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 	data.SetId(resp.Form.Id)
 	return buildResourceDataFromForm(data, resp.Form)
 }
 
-func deleteForm(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteForm(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteForm(id)
+	resp, faErrs, err := client.FAClient.DeleteFormWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
@@ -19,6 +19,7 @@ func resourceFormField() *schema.Resource {
 		ReadContext:   readFormField,
 		UpdateContext: updateFormField,
 		DeleteContext: deleteFormField,
+		CustomizeDiff: validateFormFieldConsent,
 		Schema: map[string]*schema.Schema{
 			"form_field_id": {
 				Type:         schema.TypeString,
@@ -35,7 +36,7 @@ func resourceFormField() *schema.Resource {
 			"consent_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Description:  "The Id of an existing Consent. This field will be required when the type is set to consent.",
+				Description:  "The Id of an existing Consent. This field is required when the type is set to consent.",
 				ValidateFunc: validation.IsUUID,
 			},
 			"control": {
@@ -131,29 +132,29 @@ func resourceFormField() *schema.Resource {
 	}
 }
 
-func createFormField(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createFormField(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	f := buildFormField(data)
 	var fid string
 	if fi, ok := data.GetOk("form_field_id"); ok {
 		fid = fi.(string)
 	}
-	resp, faErrs, err := client.FAClient.CreateFormField(fid, fusionauth.FormFieldRequest{Field: f})
+	resp, faErrs, err := client.FAClient.CreateFormFieldWithContext(ctx, fid, fusionauth.FormFieldRequest{Field: f})
 	if err != nil {
 		return diag.Errorf("createFormField err: %v", err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 	data.SetId(resp.Field.Id)
 	return buildResourceDataFromFormField(data, resp.Field)
 }
 
-func readFormField(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readFormField(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, err := client.FAClient.RetrieveFormField(id)
+	resp, err := client.FAClient.RetrieveFormFieldWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -169,31 +170,31 @@ func readFormField(_ context.Context, data *schema.ResourceData, i interface{})
 	return buildResourceDataFromFormField(data, resp.Field)
 }
 
-func updateFormField(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateFormField(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	f := buildFormField(data)
 
-	resp, faErrs, err := client.FAClient.UpdateFormField(data.Id(), fusionauth.FormFieldRequest{Field: f})
+	resp, faErrs, err := client.FAClient.UpdateFormFieldWithContext(ctx, data.Id(), fusionauth.FormFieldRequest{Field: f})
 	if err != nil {
 		return diag.Errorf("UpdateFormField err: %v", err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 	data.SetId(resp.Field.Id)
 	return buildResourceDataFromFormField(data, resp.Field)
 }
 
-func deleteFormField(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteFormField(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteFormField(id)
+	resp, faErrs, err := client.FAClient.DeleteFormFieldWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
@@ -262,6 +263,19 @@ func buildResourceDataFromFormField(data *schema.ResourceData, f fusionauth.Form
 	return nil
 }
 
+// validateFormFieldConsent validates at plan time that a consent field
+// references a consent, as FusionAuth would otherwise only reject it on apply.
+func validateFormFieldConsent(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Get("type").(string) != "consent" || !diff.NewValueKnown("consent_id") {
+		return nil
+	}
+	if diff.Get("consent_id").(string) == "" {
+		return fmt.Errorf("consent_id: required when type is %q", "consent")
+	}
+
+	return nil
+}
+
 func validateKey(i interface{}, k string) (warnings []string, errors []error) {
 	v, ok := i.(string)
 	if !ok {
@@ -289,7 +303,7 @@ func validateKey(i interface{}, k string) (warnings []string, errors []error) {
 		"user.username":
 		return warnings, errors
 	default:
-		if !strings.HasPrefix(v, "user.data.") && !strings.HasPrefix(v, "registration.data.") {
+		if !strings.HasPrefix(v, "user.data.") && !strings.HasPrefix(v, "registration.data.") && !strings.HasPrefix(v, "consents[") {
 			errors = append(
 				errors,
 				fmt.Errorf(
@@ -317,6 +331,7 @@ func validateKey(i interface{}, k string) (warnings []string, errors []error) {
 					[]string{
 						"user.data.",
 						"registration.data.",
+						"consents[",
 					},
 				),
 			)
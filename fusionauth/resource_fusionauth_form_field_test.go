@@ -1,9 +1,12 @@
 package fusionauth
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func Test_validateKey(t *testing.T) {
@@ -35,6 +38,15 @@ func Test_validateKey(t *testing.T) {
 			wantErrors:   nil,
 			wantWarnings: nil,
 		},
+		{
+			name: "consent",
+			args: args{
+				i: "consents['0c5d1c53-2f8f-4d8a-9b84-8a4b3f6a2c11']",
+				k: "key",
+			},
+			wantErrors:   nil,
+			wantWarnings: nil,
+		},
 		{
 			name: "invalid type",
 			args: args{
@@ -112,3 +124,42 @@ func Test_validateRegex(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateFormFieldConsent(t *testing.T) {
+	// unknownValue is how the SDK marks a value not known until apply.
+	const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+	tests := []struct {
+		name    string
+		config  map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name:   "not a consent",
+			config: map[string]interface{}{"type": "string"},
+		},
+		{
+			name:   "consent",
+			config: map[string]interface{}{"type": "consent", "consent_id": "0c5d1c53-2f8f-4d8a-9b84-8a4b3f6a2c11"},
+		},
+		{
+			name:   "consent created in the same apply",
+			config: map[string]interface{}{"type": "consent", "consent_id": unknownValue},
+		},
+		{
+			name:    "consent without a consent_id",
+			config:  map[string]interface{}{"type": "consent"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config["key"] = "user.data.marketing"
+			tt.config["name"] = "Marketing"
+			_, err := resourceFormField().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tt.config), Client{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Diff() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
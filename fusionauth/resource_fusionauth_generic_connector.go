@@ -116,8 +116,8 @@ func createGenericConnector(ctx context.Context, data *schema.ResourceData, i in
 		return diag.Errorf("CreateGenericConnector err: %v", err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 	data.SetId(resp.Connector.Id)
 	return nil
@@ -136,8 +136,8 @@ func readGenericConnector(ctx context.Context, data *schema.ResourceData, i inte
 		data.SetId("")
 		return nil
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	connector := resp.Connector
@@ -184,24 +184,24 @@ func updateGenericConnector(ctx context.Context, data *schema.ResourceData, i in
 		return diag.FromErr(err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
 }
 
-func deleteGenericConnector(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteGenericConnector(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteConnector(id)
+	resp, faErrs, err := client.FAClient.DeleteConnectorWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
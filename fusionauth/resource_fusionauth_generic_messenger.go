@@ -0,0 +1,135 @@
+package fusionauth
+
+import (
+	"context"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func newGenericMessenger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createGenericMessenger,
+		ReadContext:   readGenericMessenger,
+		UpdateContext: updateGenericMessenger,
+		DeleteContext: deleteMessenger,
+		Schema: messengerSchema(map[string]*schema.Schema{
+			"connect_timeout": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The connect timeout for the HTTP connection, in milliseconds. Value must be greater than 0.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "An object that can hold HTTPHeader key and value pairs.",
+			},
+			"http_authentication_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The basic authentication password to use for requests to the Messenger.",
+			},
+			"http_authentication_username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The basic authentication username to use for requests to the Messenger.",
+			},
+			"read_timeout": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The read timeout for the HTTP connection, in milliseconds. Value must be greater than 0.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"ssl_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An SSL certificate. The certificate is used for client certificate authentication in requests to the Messenger.",
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The fully qualified URL used to send an HTTP request.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+		}),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func buildGenericMessenger(data *schema.ResourceData) fusionauth.GenericMessengerConfiguration {
+	messenger := fusionauth.GenericMessengerConfiguration{
+		BaseMessengerConfiguration: buildBaseMessenger(data, fusionauth.MessengerType_Generic),
+		ConnectTimeout:             data.Get("connect_timeout").(int),
+		HttpAuthenticationPassword: data.Get("http_authentication_password").(string),
+		HttpAuthenticationUsername: data.Get("http_authentication_username").(string),
+		ReadTimeout:                data.Get("read_timeout").(int),
+		SslCertificate:             data.Get("ssl_certificate").(string),
+		Url:                        data.Get("url").(string),
+	}
+
+	if i, ok := data.GetOk("headers"); ok {
+		messenger.Headers = intMapToStringMap(i.(map[string]interface{}))
+	}
+
+	return messenger
+}
+
+func createGenericMessenger(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	messenger, diags := createMessenger(ctx, data, i.(Client), data.Get("id").(string), buildGenericMessenger(data))
+	if diags != nil {
+		return diags
+	}
+
+	data.SetId(messenger.Id)
+	return nil
+}
+
+func readGenericMessenger(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	messenger, diags := retrieveMessenger[fusionauth.GenericMessengerConfiguration](ctx, data, i.(Client))
+	if messenger == nil {
+		return diags
+	}
+
+	if diags := buildResourceDataFromBaseMessenger(data, messenger.BaseMessengerConfiguration); diags != nil {
+		return diags
+	}
+	if err := data.Set("connect_timeout", messenger.ConnectTimeout); err != nil {
+		return diag.Errorf("messenger.connect_timeout: %s", err.Error())
+	}
+	if err := data.Set("headers", messenger.Headers); err != nil {
+		return diag.Errorf("messenger.headers: %s", err.Error())
+	}
+	// FusionAuth may not return the password, so only overwrite the
+	// configured value if one was returned.
+	if messenger.HttpAuthenticationPassword != "" {
+		if err := data.Set("http_authentication_password", messenger.HttpAuthenticationPassword); err != nil {
+			return diag.Errorf("messenger.http_authentication_password: %s", err.Error())
+		}
+	}
+	if err := data.Set("http_authentication_username", messenger.HttpAuthenticationUsername); err != nil {
+		return diag.Errorf("messenger.http_authentication_username: %s", err.Error())
+	}
+	if err := data.Set("read_timeout", messenger.ReadTimeout); err != nil {
+		return diag.Errorf("messenger.read_timeout: %s", err.Error())
+	}
+	if err := data.Set("ssl_certificate", messenger.SslCertificate); err != nil {
+		return diag.Errorf("messenger.ssl_certificate: %s", err.Error())
+	}
+	if err := data.Set("url", messenger.Url); err != nil {
+		return diag.Errorf("messenger.url: %s", err.Error())
+	}
+
+	return nil
+}
+
+func updateGenericMessenger(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	_, diags := updateMessenger(ctx, data, i.(Client), buildGenericMessenger(data))
+	return diags
+}
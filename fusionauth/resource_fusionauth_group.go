@@ -43,8 +43,10 @@ func newGroup() *schema.Resource {
 			},
 			"tenant_id": {
 				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "The unique Id of the tenant used to scope this API request.",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The unique Id of the tenant used to scope this API request. Defaults to the tenant_id of the provider.",
 				ValidateFunc: validation.IsUUID,
 			},
 		},
@@ -73,32 +75,34 @@ func buildGroup(data *schema.ResourceData) fusionauth.GroupRequest {
 	return g
 }
 
-func createGroup(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createGroup(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	g := buildGroup(data)
-	oldTenantID := client.FAClient.TenantId
-	client.FAClient.TenantId = g.Group.TenantId
-	defer func() {
-		client.FAClient.TenantId = oldTenantID
-	}()
-	resp, faErrs, err := client.FAClient.CreateGroup(g.Group.Id, g)
+
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
+
+	resp, faErrs, err := client.FAClient.CreateGroupWithContext(ctx, g.Group.Id, g)
 	if err != nil {
 		return diag.Errorf("CreateGroup err: %v", err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	data.SetId(resp.Group.Id)
 	return nil
 }
 
-func readGroup(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readGroup(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.RetrieveGroup(id)
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
+
+	resp, faErrs, err := client.FAClient.RetrieveGroupWithContext(ctx, id)
 	if err != nil {
 		return diag.Errorf("RetrieveGroup err: %v", err)
 	}
@@ -107,8 +111,8 @@ func readGroup(_ context.Context, data *schema.ResourceData, i interface{}) diag
 		data.SetId("")
 		return nil
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	t := resp.Group
@@ -135,39 +139,41 @@ func readGroup(_ context.Context, data *schema.ResourceData, i interface{}) diag
 	return nil
 }
 
-func updateGroup(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateGroup(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	g := buildGroup(data)
 	id := data.Id()
-	oldTenantID := client.FAClient.TenantId
-	client.FAClient.TenantId = g.Group.TenantId
-	defer func() {
-		client.FAClient.TenantId = oldTenantID
-	}()
-	resp, faErrs, err := client.FAClient.UpdateGroup(id, g)
+
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
+
+	resp, faErrs, err := client.FAClient.UpdateGroupWithContext(ctx, id, g)
 
 	if err != nil {
 		return diag.Errorf("UpdateGroup err: %v", err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
 }
 
-func deleteGroup(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteGroup(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteGroup(id)
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
+
+	resp, faErrs, err := client.FAClient.DeleteGroupWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
@@ -0,0 +1,375 @@
+package fusionauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// groupMemberSearchPageSize is the number of members retrieved per search
+// request when reading all members of a group.
+const groupMemberSearchPageSize = 100
+
+func newGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages either the membership of a single user in a group, or, if members is set, " +
+			"all members of a group. Members added outside Terraform are removed from the group in the latter case, also on destroy.",
+		CreateContext: createGroupMembership,
+		ReadContext:   readGroupMembership,
+		UpdateContext: updateGroupMembership,
+		DeleteContext: deleteGroupMembership,
+		Importer: &schema.ResourceImporter{
+			StateContext: importGroupMembership,
+		},
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The Id of the Group.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"data": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Description:      "An object that can hold any information about the membership of user_id that should be persisted. Must be a JSON string.",
+				DiffSuppressFunc: diffSuppressJSON,
+				ValidateFunc:     validation.StringIsJSON,
+				ConflictsWith:    []string{"member"},
+			},
+			"member": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				MinItems:    1,
+				Description: "The authoritative set of members of the Group. Conflicts with user_id.",
+				Set:         hashGroupMember,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Description:      "An object that can hold any information about the membership that should be persisted. Must be a JSON string.",
+							DiffSuppressFunc: diffSuppressJSON,
+							ValidateFunc:     validation.StringIsJSON,
+						},
+						"user_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The Id of the User.",
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+				ExactlyOneOf: []string{"member", "user_id"},
+			},
+			"tenant_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The unique Id of the tenant of the Group, used to scope this API request. Defaults to the tenant_id of the provider.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"user_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The Id of the User to add to the Group. Conflicts with member.",
+				ValidateFunc: validation.IsUUID,
+				ExactlyOneOf: []string{"member", "user_id"},
+			},
+		},
+	}
+}
+
+// groupMembershipID returns the ID of the resource, "<group_id>:<user_id>"
+// for a single membership or the group ID for all members of a group.
+func groupMembershipID(groupID, userID string) string {
+	if userID == "" {
+		return groupID
+	}
+
+	return groupID + ":" + userID
+}
+
+// importGroupMembership imports a single membership by
+// "<group_id>:<user_id>" or all members of a group by "<group_id>".
+func importGroupMembership(_ context.Context, data *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(data.Id(), ":")
+	if len(parts) > 2 || parts[0] == "" || (len(parts) == 2 && parts[1] == "") {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <group_id>:<user_id> or <group_id>", data.Id())
+	}
+
+	if err := data.Set("group_id", parts[0]); err != nil {
+		return nil, fmt.Errorf("group_membership.group_id: %s", err.Error())
+	}
+	if len(parts) == 2 {
+		if err := data.Set("user_id", parts[1]); err != nil {
+			return nil, fmt.Errorf("group_membership.user_id: %s", err.Error())
+		}
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
+
+// groupMemberDataJSON returns the data of a member as compact JSON, the
+// format of jsonencode, so that members read back match the configured ones.
+func groupMemberDataJSON(memberData map[string]interface{}) (string, diag.Diagnostics) {
+	if len(memberData) == 0 {
+		return "", nil
+	}
+
+	b, err := json.Marshal(memberData)
+	if err != nil {
+		return "", diag.Errorf("group_membership.data: %s", err.Error())
+	}
+
+	return string(b), nil
+}
+
+// hashGroupMember hashes a member by its user ID and its data as compact
+// JSON. Otherwise equal data formatted differently, i.e. a heredoc rather than
+// jsonencode, would replace the member on every plan.
+func hashGroupMember(v interface{}) int {
+	member := v.(map[string]interface{})
+
+	memberData := member["data"].(string)
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(memberData), &m); err == nil {
+		memberData, _ = groupMemberDataJSON(m)
+	}
+
+	return schema.HashString(member["user_id"].(string) + ":" + memberData)
+}
+
+// buildGroupMembers returns the members configured for the resource, either
+// user_id or every member.
+func buildGroupMembers(data *schema.ResourceData) ([]fusionauth.GroupMember, diag.Diagnostics) {
+	if userID := data.Get("user_id").(string); userID != "" {
+		memberData, diags := jsonStringToMapStringInterface(data.Get("data").(string))
+		if diags != nil {
+			return nil, diags
+		}
+
+		return []fusionauth.GroupMember{{UserId: userID, Data: memberData}}, nil
+	}
+
+	var members []fusionauth.GroupMember
+	for _, m := range data.Get("member").(*schema.Set).List() {
+		member := m.(map[string]interface{})
+		memberData, diags := jsonStringToMapStringInterface(member["data"].(string))
+		if diags != nil {
+			return nil, diags
+		}
+
+		members = append(members, fusionauth.GroupMember{UserId: member["user_id"].(string), Data: memberData})
+	}
+
+	return members, nil
+}
+
+func createGroupMembership(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
+
+	groupID := data.Get("group_id").(string)
+	userID := data.Get("user_id").(string)
+
+	members, diags := buildGroupMembers(data)
+	if diags != nil {
+		return diags
+	}
+
+	req := fusionauth.MemberRequest{Members: map[string][]fusionauth.GroupMember{groupID: members}}
+
+	var resp *fusionauth.MemberResponse
+	var faErrs *fusionauth.Errors
+	var err error
+	if userID != "" {
+		resp, faErrs, err = client.FAClient.CreateGroupMembersWithContext(ctx, req)
+	} else {
+		// Replaces all existing members of the group.
+		resp, faErrs, err = client.FAClient.UpdateGroupMembersWithContext(ctx, req)
+	}
+	if err != nil {
+		return diag.Errorf("CreateGroupMembers err: %v", err)
+	}
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	data.SetId(groupMembershipID(groupID, userID))
+	return readGroupMembership(ctx, data, i)
+}
+
+func readGroupMembership(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
+
+	groupID := data.Get("group_id").(string)
+	userID := data.Get("user_id").(string)
+
+	groupResp, faErrs, err := client.FAClient.RetrieveGroupWithContext(ctx, groupID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if groupResp.StatusCode == http.StatusNotFound {
+		data.SetId("")
+		return nil
+	}
+	if diags := checkResponseDiagnostics(data, groupResp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+	if err := data.Set("tenant_id", groupResp.Group.TenantId); err != nil {
+		return diag.Errorf("group_membership.tenant_id: %s", err.Error())
+	}
+
+	members, diags := searchGroupMembers(ctx, data, client, groupID, userID)
+	if diags != nil {
+		return diags
+	}
+
+	if userID != "" {
+		if len(members) == 0 {
+			data.SetId("")
+			return nil
+		}
+
+		memberData, diags := groupMemberDataJSON(members[0].Data)
+		if diags != nil {
+			return diags
+		}
+		if err := data.Set("data", memberData); err != nil {
+			return diag.Errorf("group_membership.data: %s", err.Error())
+		}
+		return nil
+	}
+
+	m := make([]map[string]interface{}, 0, len(members))
+	for _, member := range members {
+		memberData, diags := groupMemberDataJSON(member.Data)
+		if diags != nil {
+			return diags
+		}
+		m = append(m, map[string]interface{}{
+			"data":    memberData,
+			"user_id": member.UserId,
+		})
+	}
+	if err := data.Set("member", m); err != nil {
+		return diag.Errorf("group_membership.member: %s", err.Error())
+	}
+
+	return nil
+}
+
+// searchGroupMembers retrieves the members of a group, limited to userID if
+// given.
+func searchGroupMembers(ctx context.Context, data *schema.ResourceData, client Client, groupID, userID string) ([]fusionauth.GroupMember, diag.Diagnostics) {
+	var members []fusionauth.GroupMember
+	for {
+		resp, faErrs, err := client.FAClient.SearchGroupMembersWithContext(ctx, fusionauth.GroupMemberSearchRequest{
+			Search: fusionauth.GroupMemberSearchCriteria{
+				BaseSearchCriteria: fusionauth.BaseSearchCriteria{
+					NumberOfResults: groupMemberSearchPageSize,
+					StartRow:        len(members),
+				},
+				GroupId: groupID,
+				UserId:  userID,
+			},
+		})
+		if err != nil {
+			return nil, diag.Errorf("SearchGroupMembers err: %v", err)
+		}
+		if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+			return nil, diags
+		}
+
+		members = append(members, resp.Members...)
+		if len(resp.Members) == 0 || int64(len(members)) >= resp.Total {
+			return members, nil
+		}
+	}
+}
+
+func updateGroupMembership(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
+
+	groupID := data.Get("group_id").(string)
+
+	// Everything but the members of a group forces a new resource.
+	members, diags := buildGroupMembers(data)
+	if diags != nil {
+		return diags
+	}
+
+	resp, faErrs, err := client.FAClient.UpdateGroupMembersWithContext(ctx, fusionauth.MemberRequest{
+		Members: map[string][]fusionauth.GroupMember{groupID: members},
+	})
+	if err != nil {
+		return diag.Errorf("UpdateGroupMembers err: %v", err)
+	}
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	return readGroupMembership(ctx, data, i)
+}
+
+func deleteGroupMembership(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
+
+	groupID := data.Get("group_id").(string)
+	userID := data.Get("user_id").(string)
+
+	// The members of the group are authoritative, so members added since the
+	// last refresh are removed too.
+	userIDs := []string{userID}
+	if userID == "" {
+		members, diags := searchGroupMembers(ctx, data, client, groupID, "")
+		if diags != nil {
+			return diags
+		}
+		if len(members) == 0 {
+			return nil
+		}
+
+		userIDs = make([]string, 0, len(members))
+		for _, m := range members {
+			userIDs = append(userIDs, m.UserId)
+		}
+	}
+
+	resp, faErrs, err := client.FAClient.DeleteGroupMembersWithContext(ctx, fusionauth.MemberDeleteRequest{
+		Members: map[string][]string{groupID: userIDs},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	return nil
+}
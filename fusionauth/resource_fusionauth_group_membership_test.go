@@ -0,0 +1,222 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccGroupMembership(t *testing.T) {
+	resourceName := randString10()
+	tfResourcePath := fmt.Sprintf("fusionauth_group_membership.test_%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipConfig(resourceName, "owner"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfResourcePath, "member.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(tfResourcePath, "member.*", map[string]string{
+						"data": `{"role":"owner"}`,
+					}),
+				),
+			},
+			{
+				Config:             testAccGroupMembershipConfig(resourceName, "owner"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				Config: testAccGroupMembershipConfig(resourceName, "admin"),
+				Check: resource.TestCheckTypeSetElemNestedAttrs(tfResourcePath, "member.*", map[string]string{
+					"data": `{"role":"admin"}`,
+				}),
+			},
+			{
+				ResourceName:      tfResourcePath,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGroupMembershipConfig(resourceName, role string) string {
+	return testAccUserResourceConfigBase(resourceName) + fmt.Sprintf(`
+	resource "fusionauth_group" "test_%[1]s" {
+		name      = "group-%[1]s"
+		tenant_id = fusionauth_tenant.test_%[1]s.id
+	}
+
+	resource "fusionauth_user" "owner_%[1]s" {
+		email     = "owner-%[1]s@example.com"
+		tenant_id = fusionauth_tenant.test_%[1]s.id
+	}
+
+	resource "fusionauth_user" "member_%[1]s" {
+		email     = "member-%[1]s@example.com"
+		tenant_id = fusionauth_tenant.test_%[1]s.id
+	}
+
+	resource "fusionauth_group_membership" "test_%[1]s" {
+		group_id = fusionauth_group.test_%[1]s.id
+
+		member {
+			user_id = fusionauth_user.owner_%[1]s.id
+			data    = jsonencode({ role = "%[2]s" })
+		}
+		member {
+			user_id = fusionauth_user.member_%[1]s.id
+		}
+	}
+	`, resourceName, role)
+}
+
+func Test_groupMembership_memberDataHasNoDiff(t *testing.T) {
+	fake, client := newFakeClient(t)
+	r := newGroupMembership()
+
+	groupID := fake.create("group", map[string]interface{}{"name": "Admins"})
+	owner := fake.create("user", map[string]interface{}{})
+	member := fake.create("user", map[string]interface{}{})
+
+	config := map[string]interface{}{
+		"group_id": groupID,
+		"member": []interface{}{
+			// As written by jsonencode.
+			map[string]interface{}{"user_id": owner, "data": `{"role":"owner","teams":["a","b"]}`},
+			// As written in a heredoc.
+			map[string]interface{}{"user_id": member, "data": "{\n  \"role\": \"member\"\n}\n"},
+		},
+	}
+
+	data := applyConfig(t, r, config, client)
+	for _, m := range data.Get("member").(*schema.Set).List() {
+		want := map[string]string{owner: `{"role":"owner","teams":["a","b"]}`, member: `{"role":"member"}`}
+		m := m.(map[string]interface{})
+		if got := m["data"]; got != want[m["user_id"].(string)] {
+			t.Errorf("data of %s = %q, want %q", m["user_id"], got, want[m["user_id"].(string)])
+		}
+	}
+
+	if diff := planDiff(t, r, data, config, client); diff != nil {
+		t.Errorf("expected no changes after apply, got %#v", diff.Attributes)
+	}
+}
+
+func Test_groupMembership_authoritative(t *testing.T) {
+	fake, client := newFakeClient(t)
+	ctx := context.Background()
+
+	groupID := fake.create("group", map[string]interface{}{"name": "Admins"})
+	outsider := fake.create("user", map[string]interface{}{})
+	fake.members[groupID] = []map[string]interface{}{{"userId": outsider}}
+
+	// More members than fit on a single page of search results.
+	members := make([]interface{}, 0, groupMemberSearchPageSize+1)
+	for i := 0; i <= groupMemberSearchPageSize; i++ {
+		members = append(members, map[string]interface{}{
+			"user_id": fake.create("user", map[string]interface{}{}),
+			"data":    "",
+		})
+	}
+	members[0].(map[string]interface{})["data"] = `{"role":"owner"}`
+
+	r := newGroupMembership()
+	data := r.TestResourceData()
+	_ = data.Set("group_id", groupID)
+	_ = data.Set("member", members)
+
+	if diags := createGroupMembership(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if data.Id() != groupID {
+		t.Errorf("id = %q, want %q", data.Id(), groupID)
+	}
+	if got := data.Get("member").(*schema.Set).Len(); got != len(members) {
+		t.Errorf("read %d members, want %d", got, len(members))
+	}
+	for _, m := range fake.members[groupID] {
+		if m["userId"] == outsider {
+			t.Error("expected the member added outside Terraform to be removed")
+		}
+	}
+
+	// Added outside Terraform after the last refresh.
+	fake.members[groupID] = append(fake.members[groupID], map[string]interface{}{"userId": outsider})
+
+	if diags := deleteGroupMembership(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if n := len(fake.members[groupID]); n != 0 {
+		t.Errorf("%d members left after delete", n)
+	}
+}
+
+func Test_importGroupMembership(t *testing.T) {
+	tests := []struct {
+		id          string
+		wantGroupID string
+		wantUserID  string
+		wantErr     bool
+	}{
+		{id: "group", wantGroupID: "group"},
+		{id: "group:user", wantGroupID: "group", wantUserID: "user"},
+		{id: "group:", wantErr: true},
+		{id: ":user", wantErr: true},
+		{id: "group:user:extra", wantErr: true},
+	}
+	for _, tt := range tests {
+		data := newGroupMembership().TestResourceData()
+		data.SetId(tt.id)
+
+		_, err := importGroupMembership(context.Background(), data, nil)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("importGroupMembership(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		if got := data.Get("group_id").(string); got != tt.wantGroupID {
+			t.Errorf("importGroupMembership(%q) group_id = %q, want %q", tt.id, got, tt.wantGroupID)
+		}
+		if got := data.Get("user_id").(string); got != tt.wantUserID {
+			t.Errorf("importGroupMembership(%q) user_id = %q, want %q", tt.id, got, tt.wantUserID)
+		}
+	}
+}
+
+func Test_groupMembership_otherTenant(t *testing.T) {
+	fake, client := newFakeClient(t)
+	ctx := context.Background()
+
+	providerTenantID := fake.create("tenant", map[string]interface{}{"name": "Default"})
+	tenantID := fake.create("tenant", map[string]interface{}{"name": "Other"})
+	groupID := fake.create("group", map[string]interface{}{"name": "Admins", "tenantId": tenantID})
+	userID := fake.create("user", map[string]interface{}{"tenantId": tenantID})
+	client.FAClient.TenantId = providerTenantID
+
+	data := newGroupMembership().TestResourceData()
+	_ = data.Set("group_id", groupID)
+	_ = data.Set("tenant_id", tenantID)
+	_ = data.Set("member", []interface{}{map[string]interface{}{"user_id": userID}})
+
+	if diags := createGroupMembership(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if got := data.Get("member").(*schema.Set).Len(); data.Id() == "" || got != 1 {
+		t.Fatalf("expected the members of a group of another tenant than the provider's to be read, got %d", got)
+	}
+
+	if diags := deleteGroupMembership(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if got := len(fake.members[groupID]); got != 0 {
+		t.Errorf("expected the members of a group of another tenant than the provider's to be removed, %d left", got)
+	}
+}
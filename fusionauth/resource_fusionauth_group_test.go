@@ -0,0 +1,61 @@
+package fusionauth
+
+import (
+	"context"
+	"testing"
+)
+
+func Test_group_otherTenant(t *testing.T) {
+	fake, client := newFakeClient(t)
+	ctx := context.Background()
+
+	providerTenantID := fake.create("tenant", map[string]interface{}{"name": "Default"})
+	tenantID := fake.create("tenant", map[string]interface{}{"name": "Other"})
+	groupID := fake.create("group", map[string]interface{}{"name": "Admins", "tenantId": tenantID})
+	client.FAClient.TenantId = providerTenantID
+
+	data := newGroup().TestResourceData()
+	data.SetId(groupID)
+	_ = data.Set("tenant_id", tenantID)
+
+	if diags := readGroup(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if data.Id() != groupID {
+		t.Fatal("expected the group of another tenant than the provider's to be read")
+	}
+
+	_ = data.Set("name", "Administrators")
+	if diags := updateGroup(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if got := fake.collection("group")[groupID]["name"]; got != "Administrators" {
+		t.Errorf("name = %v, want %q", got, "Administrators")
+	}
+
+	if diags := deleteGroup(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if _, ok := fake.collection("group")[groupID]; ok {
+		t.Error("expected the group of another tenant than the provider's to be deleted")
+	}
+}
+
+func Test_group_providerTenant(t *testing.T) {
+	fake, client := newFakeClient(t)
+	ctx := context.Background()
+
+	client.FAClient.TenantId = fake.create("tenant", map[string]interface{}{"name": "Default"})
+
+	data := newGroup().TestResourceData()
+	_ = data.Set("name", "Admins")
+	if diags := createGroup(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if diags := readGroup(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if got := data.Get("tenant_id"); got != client.FAClient.TenantId {
+		t.Errorf("tenant_id = %q, want the provider's tenant %q", got, client.FAClient.TenantId)
+	}
+}
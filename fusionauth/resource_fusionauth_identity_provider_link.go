@@ -0,0 +1,174 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func newIdentityProviderLink() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Links a user to their identity at an identity provider. Links cannot be updated, any change replaces the link.",
+		CreateContext: createIdentityProviderLink,
+		ReadContext:   readIdentityProviderLink,
+		DeleteContext: deleteIdentityProviderLink,
+		Importer: &schema.ResourceImporter{
+			StateContext: importIdentityProviderLink,
+		},
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name the user is known by at the identity provider, shown in the FusionAuth admin UI. FusionAuth may update it when the user logs in, which does not replace the link.",
+			},
+			"identity_provider_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The Id of the identity provider.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"identity_provider_user_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The Id of the user at the identity provider.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The token returned by the identity provider, i.e. a refresh token. It is not read back from FusionAuth, so changes made outside Terraform are not detected.",
+			},
+			"user_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The Id of the FusionAuth user.",
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+// identityProviderLinkID returns the ID of the resource,
+// "<identity_provider_id>:<user_id>:<identity_provider_user_id>". The
+// identity provider user ID comes last, as it may contain colons.
+func identityProviderLinkID(identityProviderID, userID, identityProviderUserID string) string {
+	return identityProviderID + ":" + userID + ":" + identityProviderUserID
+}
+
+// importIdentityProviderLink imports a link by
+// "<identity_provider_id>:<user_id>:<identity_provider_user_id>".
+func importIdentityProviderLink(_ context.Context, data *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(data.Id(), ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <identity_provider_id>:<user_id>:<identity_provider_user_id>", data.Id())
+	}
+
+	if err := data.Set("identity_provider_id", parts[0]); err != nil {
+		return nil, fmt.Errorf("identity_provider_link.identity_provider_id: %s", err.Error())
+	}
+	if err := data.Set("user_id", parts[1]); err != nil {
+		return nil, fmt.Errorf("identity_provider_link.user_id: %s", err.Error())
+	}
+	if err := data.Set("identity_provider_user_id", parts[2]); err != nil {
+		return nil, fmt.Errorf("identity_provider_link.identity_provider_user_id: %s", err.Error())
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
+
+func createIdentityProviderLink(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	link := fusionauth.IdentityProviderLink{
+		DisplayName:            data.Get("display_name").(string),
+		IdentityProviderId:     data.Get("identity_provider_id").(string),
+		IdentityProviderUserId: data.Get("identity_provider_user_id").(string),
+		Token:                  data.Get("token").(string),
+		UserId:                 data.Get("user_id").(string),
+	}
+
+	resp, faErrs, err := client.FAClient.CreateUserLinkWithContext(ctx, fusionauth.IdentityProviderLinkRequest{
+		IdentityProviderLink: link,
+	})
+	if err != nil {
+		return diag.Errorf("CreateUserLink err: %v", err)
+	}
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	data.SetId(identityProviderLinkID(link.IdentityProviderId, link.UserId, link.IdentityProviderUserId))
+	return readIdentityProviderLink(ctx, data, i)
+}
+
+func readIdentityProviderLink(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, faErrs, err := client.FAClient.RetrieveUserLinkWithContext(
+		ctx,
+		data.Get("identity_provider_id").(string),
+		data.Get("identity_provider_user_id").(string),
+		data.Get("user_id").(string),
+	)
+	if err != nil {
+		return diag.Errorf("RetrieveUserLink err: %v", err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		data.SetId("")
+		return nil
+	}
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	link := resp.IdentityProviderLink
+	if link.UserId == "" {
+		// The link has been removed outside Terraform.
+		data.SetId("")
+		return nil
+	}
+
+	// FusionAuth updates the display name and token of a link when the user
+	// logs in through the identity provider. Links cannot be updated, so the
+	// configured display name is kept rather than replacing the link, and the
+	// token is never read back.
+	if _, ok := data.GetOk("display_name"); !ok {
+		if err := data.Set("display_name", link.DisplayName); err != nil {
+			return diag.Errorf("identity_provider_link.display_name: %s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+func deleteIdentityProviderLink(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, faErrs, err := client.FAClient.DeleteUserLinkWithContext(
+		ctx,
+		data.Get("identity_provider_id").(string),
+		data.Get("identity_provider_user_id").(string),
+		data.Get("user_id").(string),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	return checkResponseDiagnostics(data, resp.StatusCode, faErrs)
+}
@@ -0,0 +1,212 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIdentityProviderLink(t *testing.T) {
+	resourceName := randString10()
+	tfResourcePath := fmt.Sprintf("fusionauth_identity_provider_link.test_%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckIdentityProviderLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityProviderLinkConfig(resourceName, "jane"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfResourcePath, "display_name", "jane@example.com"),
+					resource.TestCheckResourceAttr(tfResourcePath, "identity_provider_user_id", "oidc-"+resourceName),
+					resource.TestCheckResourceAttrPair(tfResourcePath, "user_id", "fusionauth_user.test_"+resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccIdentityProviderLinkConfig(resourceName, "jane.doe"),
+				Check:  resource.TestCheckResourceAttr(tfResourcePath, "display_name", "jane.doe@example.com"),
+			},
+			{
+				Config:             testAccIdentityProviderLinkConfig(resourceName, "jane.doe"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:            tfResourcePath,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func testAccIdentityProviderLinkConfig(resourceName, displayName string) string {
+	return testAccUserResourceConfigBase(resourceName) + fmt.Sprintf(`
+	resource "fusionauth_idp_open_id_connect" "test_%[1]s" {
+		button_text                   = "Login with OpenID Connect"
+		name                          = "OpenID Connect %[1]s"
+		oauth2_authorization_endpoint = "https://oidc.example.com/authorize"
+		oauth2_client_id              = "client-%[1]s"
+		oauth2_client_secret          = "client-secret"
+		oauth2_token_endpoint         = "https://oidc.example.com/token"
+		oauth2_user_info_endpoint     = "https://oidc.example.com/userinfo"
+	}
+
+	resource "fusionauth_user" "test_%[1]s" {
+		email     = "link-%[1]s@example.com"
+		tenant_id = fusionauth_tenant.test_%[1]s.id
+	}
+
+	resource "fusionauth_identity_provider_link" "test_%[1]s" {
+		display_name              = "%[2]s@example.com"
+		identity_provider_id      = fusionauth_idp_open_id_connect.test_%[1]s.id
+		identity_provider_user_id = "oidc-%[1]s"
+		token                     = "refresh-token-%[1]s"
+		user_id                   = fusionauth_user.test_%[1]s.id
+	}
+	`, resourceName, displayName)
+}
+
+// testAccCheckIdentityProviderLinkDestroy checks that no link of the state can
+// still be retrieved. Links are identified by query parameters, so
+// testAccCheckDestroyed cannot be used.
+func testAccCheckIdentityProviderLinkDestroy(s *terraform.State) error {
+	client := fusionauthClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fusionauth_identity_provider_link" {
+			continue
+		}
+
+		resp, _, err := client.RetrieveUserLinkWithContext(
+			context.Background(),
+			rs.Primary.Attributes["identity_provider_id"],
+			rs.Primary.Attributes["identity_provider_user_id"],
+			rs.Primary.Attributes["user_id"],
+		)
+		if err != nil {
+			return err
+		}
+		if resp.IdentityProviderLink.UserId != "" {
+			return fmt.Errorf("fusionauth_identity_provider_link %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func Test_identityProviderLink_serverSideChanges(t *testing.T) {
+	fake, client := newFakeClient(t)
+	r := newIdentityProviderLink()
+
+	idpID := fake.create("identity-provider", map[string]interface{}{"type": "OpenIDConnect"})
+	userID := fake.create("user", map[string]interface{}{})
+	config := map[string]interface{}{
+		"display_name":              "jane@example.com",
+		"identity_provider_id":      idpID,
+		"identity_provider_user_id": "oidc:108273645",
+		"token":                     "refresh-token",
+		"user_id":                   userID,
+	}
+
+	data := applyConfig(t, r, config, client)
+	if diff := planDiff(t, r, data, config, client); diff != nil {
+		t.Errorf("expected no changes after apply, got %#v", diff.Attributes)
+	}
+
+	// FusionAuth updates the display name and token when the user logs in.
+	fake.links[0]["displayName"] = "Jane Doe"
+	fake.links[0]["token"] = "rotated-refresh-token"
+	data = refreshState(t, r, data.State(), client)
+	if diff := planDiff(t, r, data, config, client); diff != nil {
+		t.Errorf("expected no changes after a login, got %#v", diff.Attributes)
+	}
+
+	importStateVerify(t, r, data, data.Id(), client, "display_name", "token")
+	imported := r.Data(nil)
+	imported.SetId(data.Id())
+	if _, err := importIdentityProviderLink(context.Background(), imported, client); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diags := readIdentityProviderLink(context.Background(), imported, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if got := imported.Get("display_name"); got != "Jane Doe" {
+		t.Errorf("imported display_name = %q, want %q", got, "Jane Doe")
+	}
+	if got := imported.Get("token"); got != "" {
+		t.Errorf("imported token = %q, want it not to be read", got)
+	}
+}
+
+func Test_identityProviderLink_removedOutOfBand(t *testing.T) {
+	fake, client := newFakeClient(t)
+	ctx := context.Background()
+
+	idpID := fake.create("identity-provider", map[string]interface{}{"type": "Google"})
+	userID := fake.create("user", map[string]interface{}{})
+
+	data := newIdentityProviderLink().TestResourceData()
+	_ = data.Set("identity_provider_id", idpID)
+	_ = data.Set("identity_provider_user_id", "google:108273645")
+	_ = data.Set("user_id", userID)
+	_ = data.Set("display_name", "jane@example.com")
+
+	if diags := createIdentityProviderLink(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if want := idpID + ":" + userID + ":google:108273645"; data.Id() != want {
+		t.Errorf("id = %q, want %q", data.Id(), want)
+	}
+	if got := data.Get("display_name"); got != "jane@example.com" {
+		t.Errorf("display_name = %q", got)
+	}
+
+	fake.links = nil
+	if diags := readIdentityProviderLink(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if data.Id() != "" {
+		t.Error("expected a link removed outside Terraform to be removed from the state")
+	}
+}
+
+func Test_importIdentityProviderLink(t *testing.T) {
+	tests := []struct {
+		id            string
+		wantIDPUserID string
+		wantErr       bool
+	}{
+		{id: "idp:user:123", wantIDPUserID: "123"},
+		{id: "idp:user:urn:example:123", wantIDPUserID: "urn:example:123"},
+		{id: "idp:user", wantErr: true},
+		{id: "idp::123", wantErr: true},
+		{id: ":user:123", wantErr: true},
+		{id: "idp:user:", wantErr: true},
+	}
+	for _, tt := range tests {
+		data := newIdentityProviderLink().TestResourceData()
+		data.SetId(tt.id)
+
+		_, err := importIdentityProviderLink(context.Background(), data, nil)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("importIdentityProviderLink(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		if got := data.Get("identity_provider_id").(string); got != "idp" {
+			t.Errorf("importIdentityProviderLink(%q) identity_provider_id = %q, want %q", tt.id, got, "idp")
+		}
+		if got := data.Get("user_id").(string); got != "user" {
+			t.Errorf("importIdentityProviderLink(%q) user_id = %q, want %q", tt.id, got, "user")
+		}
+		if got := data.Get("identity_provider_user_id").(string); got != tt.wantIDPUserID {
+			t.Errorf("importIdentityProviderLink(%q) identity_provider_user_id = %q, want %q", tt.id, got, tt.wantIDPUserID)
+		}
+	}
+}
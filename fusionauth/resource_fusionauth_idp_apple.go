@@ -2,7 +2,6 @@ package fusionauth
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,10 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-type AppleIdentityProviderBody struct {
-	IdentityProvider fusionauth.AppleIdentityProvider `json:"identityProvider"`
-}
-
 type AppleAppConfig struct {
 	ButtonText         string `json:"buttonText,omitempty"`
 	CreateRegistration bool   `json:"createRegistration"`
@@ -187,67 +182,36 @@ func resourceIDPApple() *schema.Resource {
 	}
 }
 
-func createIDPApple(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPApple(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-
-	bb, err := createIdentityProvider(b, client, "")
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func createIDPApple(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := createIdentityProvider(ctx, data, i.(Client), "", buildIDPApple(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
 
-func readIDPApple(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
+func readIDPApple(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := retrieveIdentityProvider[fusionauth.AppleIdentityProvider](ctx, data, i.(Client))
+	if idp == nil {
+		return diags
 	}
 
-	var ipb AppleIdentityProviderBody
-	_ = json.Unmarshal(b, &ipb)
-
-	return buildResourceFromIDPApple(ipb.IdentityProvider, data)
+	return buildResourceFromIDPApple(*idp, data)
 }
 
-func updateIDPApple(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPApple(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func updateIDPApple(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := updateIdentityProvider(ctx, data, i.(Client), buildIDPApple(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
 
-func buildIDPApple(data *schema.ResourceData) AppleIdentityProviderBody {
+func buildIDPApple(data *schema.ResourceData) fusionauth.AppleIdentityProvider {
 	a := fusionauth.AppleIdentityProvider{
 		ButtonText: data.Get("button_text").(string),
 		BaseIdentityProvider: fusionauth.BaseIdentityProvider{
@@ -269,7 +233,7 @@ func buildIDPApple(data *schema.ResourceData) AppleIdentityProviderBody {
 	a.ApplicationConfiguration = buildAppleAppConfig("application_configuration", data)
 	a.TenantConfiguration = buildTenantConfiguration(data)
 
-	return AppleIdentityProviderBody{IdentityProvider: a}
+	return a
 }
 
 func buildAppleAppConfig(key string, data *schema.ResourceData) map[string]interface{} {
@@ -330,11 +294,7 @@ func buildResourceFromIDPApple(o fusionauth.AppleIdentityProvider, data *schema.
 		return diag.Errorf("idpApple.linking_strategy: %s", err.Error())
 	}
 
-	// Since this is coming down as an interface and would end up being map[string]interface{}
-	// with one of the values being map[string]interface{}
-	b, _ := json.Marshal(o.ApplicationConfiguration)
-	m := make(map[string]AppleAppConfig)
-	_ = json.Unmarshal(b, &m)
+	m := decodeApplicationConfiguration[AppleAppConfig](o.ApplicationConfiguration)
 
 	ac := make([]map[string]interface{}, 0, len(o.ApplicationConfiguration))
 	for k, v := range m {
@@ -0,0 +1,295 @@
+package fusionauth
+
+import (
+	"context"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type EpicGamesAppConfig struct {
+	ButtonText         string `json:"buttonText,omitempty"`
+	ClientID           string `json:"client_id,omitempty"`
+	ClientSecret       string `json:"client_secret,omitempty"`
+	CreateRegistration bool   `json:"createRegistration"`
+	Enabled            bool   `json:"enabled"`
+	Scope              string `json:"scope,omitempty"`
+}
+
+func resourceIDPEpicGames() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createIDPEpicGames,
+		ReadContext:   readIDPEpicGames,
+		UpdateContext: updateIDPEpicGames,
+		DeleteContext: deleteIdentityProvider,
+		Schema: map[string]*schema.Schema{
+			"idp_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The ID to use for the new identity provider. If not specified a secure random UUID will be generated.",
+				ValidateFunc: validation.IsUUID,
+				ForceNew:     true,
+			},
+			"application_configuration": {
+				Optional:    true,
+				Type:        schema.TypeSet,
+				Description: "The configuration for each Application that the identity provider is enabled for.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"button_text": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "This is an optional Application specific override for the top level button text.",
+						},
+						"client_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "This is an optional Application specific override for the top level client_id.",
+						},
+						"client_secret": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "This is an optional Application specific override for the top level client_secret.",
+						},
+						"create_registration": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Determines if a UserRegistration is created for the User automatically or not. If a user doesn’t exist in FusionAuth and logs in through an identity provider, this boolean controls whether or not FusionAuth creates a registration for the User in the Application they are logging into.",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Determines if this identity provider is enabled for the Application specified by the applicationId key.",
+						},
+						"scope": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "This is an optional Application specific override for the top level scope.",
+						},
+					},
+				},
+			},
+			"button_text": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The top-level button text to use on the FusionAuth login page for this Identity Provider.",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The top-level Epic Games client id for your Application. This value is retrieved from the Epic Games developer portal when you setup your Epic Games developer account.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The top-level client secret to use with the Epic Games Identity Provider when retrieving the long-lived token. This value is retrieved from the Epic Games developer portal when you setup your Epic Games developer account.",
+			},
+			"debug": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if debug is enabled for this provider. When enabled, each time this provider is invoked to reconcile a login an Event Log will be created.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if this provider is enabled. If it is false then it will be disabled globally.",
+			},
+			"lambda_reconcile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The unique Id of the lambda to used during the user reconcile process to map custom claims from the external identity provider to the FusionAuth user.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"linking_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"CreatePendingLink",
+					"LinkAnonymously",
+					"LinkByEmail",
+					"LinkByEmailForExistingUser",
+					"LinkByUsername",
+					"LinkByUsernameForExistingUser",
+					"Unsupported",
+				}, false),
+				Description: "The linking strategy to use when creating the link between the Epic Games Identity Provider and the user.",
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The top-level scope that you are requesting from Epic Games.",
+			},
+			"tenant_configuration": {
+				Optional:    true,
+				Type:        schema.TypeSet,
+				Description: "The configuration for each Tenant that limits the number of links a user may have for a particular identity provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tenant_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"limit_user_link_count_enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "When enabled, the number of identity provider links a user may create is enforced by maximumLinks",
+						},
+						"limit_user_link_count_maximum_links": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     42,
+							Description: "The maximum number of links a user may have for this identity provider.",
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func createIDPEpicGames(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := createIdentityProvider(ctx, data, i.(Client), data.Get("idp_id").(string), buildIDPEpicGames(data))
+	if diags != nil {
+		return diags
+	}
+
+	data.SetId(idp.Id)
+	return nil
+}
+
+func readIDPEpicGames(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := retrieveIdentityProvider[fusionauth.EpicGamesIdentityProvider](ctx, data, i.(Client))
+	if idp == nil {
+		return diags
+	}
+
+	return buildResourceDataFromIDPEpicGames(data, *idp)
+}
+
+func updateIDPEpicGames(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := updateIdentityProvider(ctx, data, i.(Client), buildIDPEpicGames(data))
+	if diags != nil {
+		return diags
+	}
+
+	data.SetId(idp.Id)
+	return nil
+}
+
+func buildIDPEpicGames(data *schema.ResourceData) fusionauth.EpicGamesIdentityProvider {
+	o := fusionauth.EpicGamesIdentityProvider{
+		BaseIdentityProvider: fusionauth.BaseIdentityProvider{
+			Debug:      data.Get("debug").(bool),
+			Enableable: buildEnableable("enabled", data),
+			LambdaConfiguration: fusionauth.ProviderLambdaConfiguration{
+				ReconcileId: data.Get("lambda_reconcile_id").(string),
+			},
+			Type:            fusionauth.IdentityProviderType_EpicGames,
+			LinkingStrategy: fusionauth.IdentityProviderLinkingStrategy(data.Get("linking_strategy").(string)),
+		},
+		ButtonText:   data.Get("button_text").(string),
+		ClientId:     data.Get("client_id").(string),
+		ClientSecret: data.Get("client_secret").(string),
+		Scope:        data.Get("scope").(string),
+	}
+
+	o.ApplicationConfiguration = buildEpicGamesAppConfig("application_configuration", data)
+	o.TenantConfiguration = buildTenantConfiguration(data)
+
+	return o
+}
+
+func buildResourceDataFromIDPEpicGames(data *schema.ResourceData, res fusionauth.EpicGamesIdentityProvider) diag.Diagnostics {
+	if err := data.Set("button_text", res.ButtonText); err != nil {
+		return diag.Errorf("idpEpicGames.button_text: %s", err.Error())
+	}
+	if err := data.Set("client_id", res.ClientId); err != nil {
+		return diag.Errorf("idpEpicGames.client_id: %s", err.Error())
+	}
+	if err := data.Set("client_secret", res.ClientSecret); err != nil {
+		return diag.Errorf("idpEpicGames.client_secret: %s", err.Error())
+	}
+	if err := data.Set("debug", res.Debug); err != nil {
+		return diag.Errorf("idpEpicGames.debug: %s", err.Error())
+	}
+	if err := data.Set("enabled", res.Enabled); err != nil {
+		return diag.Errorf("idpEpicGames.enabled: %s", err.Error())
+	}
+	if err := data.Set("lambda_reconcile_id", res.LambdaConfiguration.ReconcileId); err != nil {
+		return diag.Errorf("idpEpicGames.lambda_reconcile_id: %s", err.Error())
+	}
+	if err := data.Set("linking_strategy", res.LinkingStrategy); err != nil {
+		return diag.Errorf("idpEpicGames.linking_strategy: %s", err.Error())
+	}
+	if err := data.Set("scope", res.Scope); err != nil {
+		return diag.Errorf("idpEpicGames.scope: %s", err.Error())
+	}
+
+	m := decodeApplicationConfiguration[EpicGamesAppConfig](res.ApplicationConfiguration)
+
+	ac := make([]map[string]interface{}, 0, len(res.ApplicationConfiguration))
+	for k, v := range m {
+		ac = append(ac, map[string]interface{}{
+			"application_id":      k,
+			"button_text":         v.ButtonText,
+			"client_id":           v.ClientID,
+			"client_secret":       v.ClientSecret,
+			"create_registration": v.CreateRegistration,
+			"enabled":             v.Enabled,
+			"scope":               v.Scope,
+		})
+	}
+	if err := data.Set("application_configuration", ac); err != nil {
+		return diag.Errorf("idpEpicGames.application_configuration: %s", err.Error())
+	}
+
+	tc := buildTenantConfigurationResource(res.TenantConfiguration)
+	if err := data.Set("tenant_configuration", tc); err != nil {
+		return diag.Errorf("idpEpicGames.tenant_configuration: %s", err.Error())
+	}
+
+	return nil
+}
+
+func buildEpicGamesAppConfig(key string, data *schema.ResourceData) map[string]interface{} {
+	m := make(map[string]interface{})
+	s := data.Get(key)
+	set, ok := s.(*schema.Set)
+	if !ok {
+		return m
+	}
+	l := set.List()
+	for _, x := range l {
+		ac := x.(map[string]interface{})
+		aid := ac["application_id"].(string)
+		oc := EpicGamesAppConfig{
+			ButtonText:         ac["button_text"].(string),
+			ClientID:           ac["client_id"].(string),
+			ClientSecret:       ac["client_secret"].(string),
+			CreateRegistration: ac["create_registration"].(bool),
+			Enabled:            ac["enabled"].(bool),
+			Scope:              ac["scope"].(string),
+		}
+		m[aid] = oc
+	}
+	return m
+}
@@ -0,0 +1,58 @@
+package fusionauth
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIDPEpicGames(t *testing.T) {
+	resourceName := randString10()
+	tfResourcePath := fmt.Sprintf("fusionauth_idp_epic_games.test_%s", resourceName)
+
+	startButtonText, endButtonText := "Login with Epic Games", "Sign in with Epic Games"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("fusionauth_idp_epic_games", "/api/identity-provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDPEpicGamesConfig(resourceName, startButtonText),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfResourcePath, "button_text", startButtonText),
+					resource.TestCheckResourceAttr(tfResourcePath, "enabled", "true"),
+					resource.TestCheckResourceAttr(tfResourcePath, "client_id", "client-id-"+resourceName),
+					resource.TestCheckResourceAttr(tfResourcePath, "scope", "basic_profile"),
+				),
+			},
+			{
+				Config: testAccIDPEpicGamesConfig(resourceName, endButtonText),
+				Check:  resource.TestCheckResourceAttr(tfResourcePath, "button_text", endButtonText),
+			},
+			{
+				Config:             testAccIDPEpicGamesConfig(resourceName, endButtonText),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:            tfResourcePath,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"idp_id"},
+			},
+		},
+	})
+}
+
+func testAccIDPEpicGamesConfig(resourceName, buttonText string) string {
+	return fmt.Sprintf(`
+	resource "fusionauth_idp_epic_games" "test_%[1]s" {
+		button_text   = "%[2]s"
+		enabled       = true
+		client_id     = "client-id-%[1]s"
+		client_secret = "client-secret"
+		scope         = "basic_profile"
+	}
+	`, resourceName, buttonText)
+}
@@ -2,7 +2,6 @@ package fusionauth
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
@@ -11,10 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-type IDPExternalJWTProviderBody struct {
-	IdentityProvider fusionauth.ExternalJWTIdentityProvider `json:"identityProvider"`
-}
-
 type IDPExternalJWTAppConfig struct {
 	CreateRegistration bool `json:"createRegistration"`
 	Enabled            bool `json:"enabled"`
@@ -169,67 +164,36 @@ func resourceIDPExternalJWT() *schema.Resource {
 	}
 }
 
-func createIDPExternalJWT(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPExternalJWT(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-
-	bb, err := createIdentityProvider(b, client, data.Get("idp_id").(string))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func createIDPExternalJWT(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := createIdentityProvider(ctx, data, i.(Client), data.Get("idp_id").(string), buildIDPExternalJWT(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
 
-func readIDPExternalJWT(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
+func readIDPExternalJWT(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := retrieveIdentityProvider[fusionauth.ExternalJWTIdentityProvider](ctx, data, i.(Client))
+	if idp == nil {
+		return diags
 	}
 
-	var ipb IDPExternalJWTProviderBody
-	_ = json.Unmarshal(b, &ipb)
-
-	return buildResourceDataFromIDPExternalJWT(data, ipb.IdentityProvider)
+	return buildResourceDataFromIDPExternalJWT(data, *idp)
 }
 
-func updateIDPExternalJWT(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPExternalJWT(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func updateIDPExternalJWT(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := updateIdentityProvider(ctx, data, i.(Client), buildIDPExternalJWT(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
 
-func buildIDPExternalJWT(data *schema.ResourceData) IDPExternalJWTProviderBody {
+func buildIDPExternalJWT(data *schema.ResourceData) fusionauth.ExternalJWTIdentityProvider {
 	idp := fusionauth.ExternalJWTIdentityProvider{
 		BaseIdentityProvider: fusionauth.BaseIdentityProvider{
 			Debug:      data.Get("debug").(bool),
@@ -257,7 +221,7 @@ func buildIDPExternalJWT(data *schema.ResourceData) IDPExternalJWTProviderBody {
 
 	idp.ApplicationConfiguration = buildIDPExternalJWTAppConfig("application_configuration", data)
 	idp.TenantConfiguration = buildTenantConfiguration(data)
-	return IDPExternalJWTProviderBody{IdentityProvider: idp}
+	return idp
 }
 
 func buildIDPExternalJWTAppConfig(key string, data *schema.ResourceData) map[string]interface{} {
@@ -320,11 +284,7 @@ func buildResourceDataFromIDPExternalJWT(data *schema.ResourceData, res fusionau
 		return diag.Errorf("idpExternalJwt.linking_strategy: %s", err.Error())
 	}
 
-	// Since this is coming down as an interface and would end up being map[string]interface{}
-	// with one of the values being map[string]interface{}
-	b, _ := json.Marshal(res.ApplicationConfiguration)
-	m := make(map[string]IDPExternalJWTAppConfig)
-	_ = json.Unmarshal(b, &m)
+	m := decodeApplicationConfiguration[IDPExternalJWTAppConfig](res.ApplicationConfiguration)
 
 	ac := make([]map[string]interface{}, 0, len(res.ApplicationConfiguration))
 	for k, v := range m {
@@ -2,7 +2,6 @@ package fusionauth
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,10 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-type FacebookIdentityProviderBody struct {
-	IdentityProvider fusionauth.FacebookIdentityProvider `json:"identityProvider"`
-}
-
 type FacebookAppConfig struct {
 	AppID              string `json:"appId,omitempty"`
 	ButtonText         string `json:"buttonText,omitempty"`
@@ -188,67 +183,36 @@ func resourceIDPFacebook() *schema.Resource {
 	}
 }
 
-func createIDPFacebook(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	fbIDP := buildIDPFacebook(data)
-	b, err := json.Marshal(fbIDP)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := createIdentityProvider(b, client, "")
-	if err != nil {
-		return diag.FromErr(err)
+func createIDPFacebook(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := createIdentityProvider(ctx, data, i.(Client), "", buildIDPFacebook(data))
+	if diags != nil {
+		return diags
 	}
 
-	err = json.Unmarshal(bb, &fbIDP)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	data.SetId(fbIDP.IdentityProvider.Id)
-	return buildResourceFromIDPFacebook(fbIDP.IdentityProvider, data)
+	data.SetId(idp.Id)
+	return buildResourceFromIDPFacebook(*idp, data)
 }
 
-func readIDPFacebook(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
+func readIDPFacebook(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := retrieveIdentityProvider[fusionauth.FacebookIdentityProvider](ctx, data, i.(Client))
+	if idp == nil {
+		return diags
 	}
 
-	var ipb FacebookIdentityProviderBody
-	err = json.Unmarshal(b, &ipb)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return buildResourceFromIDPFacebook(ipb.IdentityProvider, data)
+	return buildResourceFromIDPFacebook(*idp, data)
 }
 
-func updateIDPFacebook(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	fbIDP := buildIDPFacebook(data)
-	b, err := json.Marshal(fbIDP)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
+func updateIDPFacebook(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := updateIdentityProvider(ctx, data, i.(Client), buildIDPFacebook(data))
+	if diags != nil {
+		return diags
 	}
 
-	err = json.Unmarshal(bb, &fbIDP)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	data.SetId(fbIDP.IdentityProvider.Id)
-	return buildResourceFromIDPFacebook(fbIDP.IdentityProvider, data)
+	data.SetId(idp.Id)
+	return buildResourceFromIDPFacebook(*idp, data)
 }
 
-func buildIDPFacebook(data *schema.ResourceData) FacebookIdentityProviderBody {
+func buildIDPFacebook(data *schema.ResourceData) fusionauth.FacebookIdentityProvider {
 	fbIDP := fusionauth.FacebookIdentityProvider{
 		BaseIdentityProvider: fusionauth.BaseIdentityProvider{
 			Debug:      data.Get("debug").(bool),
@@ -270,9 +234,7 @@ func buildIDPFacebook(data *schema.ResourceData) FacebookIdentityProviderBody {
 	// Compute application specific configuration and overrides.
 	fbIDP.ApplicationConfiguration = buildFacebookAppConfig("application_configuration", data)
 	fbIDP.TenantConfiguration = buildTenantConfiguration(data)
-	return FacebookIdentityProviderBody{
-		IdentityProvider: fbIDP,
-	}
+	return fbIDP
 }
 
 // buildFacebookAppConfig transforms the incoming application configuration as
@@ -337,11 +299,7 @@ func buildResourceFromIDPFacebook(res fusionauth.FacebookIdentityProvider, data
 		return diag.Errorf("idpFacebook.permissions: %s", err.Error())
 	}
 
-	// Since this is coming down as an interface and would end up being map[string]interface{}
-	// with one of the values being map[string]interface{}
-	b, _ := json.Marshal(res.ApplicationConfiguration)
-	m := make(map[string]FacebookAppConfig)
-	_ = json.Unmarshal(b, &m)
+	m := decodeApplicationConfiguration[FacebookAppConfig](res.ApplicationConfiguration)
 
 	ac := make([]map[string]interface{}, 0, len(res.ApplicationConfiguration))
 	for k, v := range m {
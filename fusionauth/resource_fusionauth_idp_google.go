@@ -2,7 +2,6 @@ package fusionauth
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,10 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-type GoogleIdentityProviderBody struct {
-	IdentityProvider fusionauth.GoogleIdentityProvider `json:"identityProvider"`
-}
-
 type GoogleAppConfig struct {
 	ButtonText         string `json:"buttonText,omitempty"`
 	ClientID           string `json:"client_id,omitempty"`
@@ -175,7 +170,7 @@ func newIDPGoogle() *schema.Resource {
 	}
 }
 
-func buildIDPGoogle(data *schema.ResourceData) GoogleIdentityProviderBody {
+func buildIDPGoogle(data *schema.ResourceData) fusionauth.GoogleIdentityProvider {
 	o := fusionauth.GoogleIdentityProvider{
 		ButtonText: data.Get("button_text").(string),
 		BaseIdentityProvider: fusionauth.BaseIdentityProvider{
@@ -195,7 +190,7 @@ func buildIDPGoogle(data *schema.ResourceData) GoogleIdentityProviderBody {
 
 	o.ApplicationConfiguration = buildGoogleAppConfig("application_configuration", data)
 	o.TenantConfiguration = buildTenantConfiguration(data)
-	return GoogleIdentityProviderBody{IdentityProvider: o}
+	return o
 }
 
 func buildGoogleAppConfig(key string, data *schema.ResourceData) map[string]interface{} {
@@ -222,41 +217,23 @@ func buildGoogleAppConfig(key string, data *schema.ResourceData) map[string]inte
 	return m
 }
 
-func createIDPGoogle(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPGoogle(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-
-	bb, err := createIdentityProvider(b, client, "")
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func createIDPGoogle(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := createIdentityProvider(ctx, data, i.(Client), "", buildIDPGoogle(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
 
-func readIDPGoogle(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
+func readIDPGoogle(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := retrieveIdentityProvider[fusionauth.GoogleIdentityProvider](ctx, data, i.(Client))
+	if idp == nil {
+		return diags
 	}
 
-	var ipb GoogleIdentityProviderBody
-	_ = json.Unmarshal(b, &ipb)
-
-	return buildResourceFromIDPGoogle(ipb.IdentityProvider, data)
+	return buildResourceFromIDPGoogle(*idp, data)
 }
 
 func buildResourceFromIDPGoogle(o fusionauth.GoogleIdentityProvider, data *schema.ResourceData) diag.Diagnostics {
@@ -287,11 +264,7 @@ func buildResourceFromIDPGoogle(o fusionauth.GoogleIdentityProvider, data *schem
 	if err := data.Set("login_method", o.LoginMethod); err != nil {
 		return diag.Errorf("idpGoogle.login_method: %s", err.Error())
 	}
-	// Since this is coming down as an interface and would end up being map[string]interface{}
-	// with one of the values being map[string]interface{}
-	b, _ := json.Marshal(o.ApplicationConfiguration)
-	m := make(map[string]GoogleAppConfig)
-	_ = json.Unmarshal(b, &m)
+	m := decodeApplicationConfiguration[GoogleAppConfig](o.ApplicationConfiguration)
 
 	ac := make([]map[string]interface{}, 0, len(o.ApplicationConfiguration))
 	for k, v := range m {
@@ -317,25 +290,12 @@ func buildResourceFromIDPGoogle(o fusionauth.GoogleIdentityProvider, data *schem
 	return nil
 }
 
-func updateIDPGoogle(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPGoogle(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func updateIDPGoogle(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := updateIdentityProvider(ctx, data, i.(Client), buildIDPGoogle(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
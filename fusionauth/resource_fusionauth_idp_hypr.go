@@ -0,0 +1,261 @@
+package fusionauth
+
+import (
+	"context"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type HYPRAppConfig struct {
+	CreateRegistration        bool   `json:"createRegistration"`
+	Enabled                   bool   `json:"enabled"`
+	RelyingPartyApplicationID string `json:"relyingPartyApplicationId,omitempty"`
+	RelyingPartyURL           string `json:"relyingPartyURL,omitempty"`
+}
+
+func resourceIDPHYPR() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createIDPHYPR,
+		ReadContext:   readIDPHYPR,
+		UpdateContext: updateIDPHYPR,
+		DeleteContext: deleteIdentityProvider,
+		Schema: map[string]*schema.Schema{
+			"idp_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The ID to use for the new identity provider. If not specified a secure random UUID will be generated.",
+				ValidateFunc: validation.IsUUID,
+				ForceNew:     true,
+			},
+			"application_configuration": {
+				Optional:    true,
+				Type:        schema.TypeSet,
+				Description: "The configuration for each Application that the identity provider is enabled for.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"create_registration": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Determines if a UserRegistration is created for the User automatically or not. If a user doesn’t exist in FusionAuth and logs in through an identity provider, this boolean controls whether or not FusionAuth creates a registration for the User in the Application they are logging into.",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Determines if this identity provider is enabled for the Application specified by the applicationId key.",
+						},
+						"relying_party_application_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "This is an optional Application specific override for the top level relying_party_application_id.",
+						},
+						"relying_party_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "This is an optional Application specific override for the top level relying_party_url.",
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+					},
+				},
+			},
+			"debug": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if debug is enabled for this provider. When enabled, each time this provider is invoked to reconcile a login an Event Log will be created.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if this provider is enabled. If it is false then it will be disabled globally.",
+			},
+			"lambda_reconcile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The unique Id of the lambda to used during the user reconcile process to map custom claims from the external identity provider to the FusionAuth user.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"linking_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"CreatePendingLink",
+					"LinkAnonymously",
+					"LinkByEmail",
+					"LinkByEmailForExistingUser",
+					"LinkByUsername",
+					"LinkByUsernameForExistingUser",
+					"Unsupported",
+				}, false),
+				Description: "The linking strategy to use when creating the link between the HYPR Identity Provider and the user.",
+			},
+			"relying_party_application_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The HYPR relying party application Id.",
+			},
+			"relying_party_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The HYPR relying party URL.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"tenant_configuration": {
+				Optional:    true,
+				Type:        schema.TypeSet,
+				Description: "The configuration for each Tenant that limits the number of links a user may have for a particular identity provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tenant_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"limit_user_link_count_enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "When enabled, the number of identity provider links a user may create is enforced by maximumLinks",
+						},
+						"limit_user_link_count_maximum_links": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     42,
+							Description: "The maximum number of links a user may have for this identity provider.",
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func createIDPHYPR(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := createIdentityProvider(ctx, data, i.(Client), data.Get("idp_id").(string), buildIDPHYPR(data))
+	if diags != nil {
+		return diags
+	}
+
+	data.SetId(idp.Id)
+	return nil
+}
+
+func readIDPHYPR(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := retrieveIdentityProvider[fusionauth.HYPRIdentityProvider](ctx, data, i.(Client))
+	if idp == nil {
+		return diags
+	}
+
+	return buildResourceDataFromIDPHYPR(data, *idp)
+}
+
+func updateIDPHYPR(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := updateIdentityProvider(ctx, data, i.(Client), buildIDPHYPR(data))
+	if diags != nil {
+		return diags
+	}
+
+	data.SetId(idp.Id)
+	return nil
+}
+
+func buildIDPHYPR(data *schema.ResourceData) fusionauth.HYPRIdentityProvider {
+	o := fusionauth.HYPRIdentityProvider{
+		BaseIdentityProvider: fusionauth.BaseIdentityProvider{
+			Debug:      data.Get("debug").(bool),
+			Enableable: buildEnableable("enabled", data),
+			LambdaConfiguration: fusionauth.ProviderLambdaConfiguration{
+				ReconcileId: data.Get("lambda_reconcile_id").(string),
+			},
+			Type:            fusionauth.IdentityProviderType_HYPR,
+			LinkingStrategy: fusionauth.IdentityProviderLinkingStrategy(data.Get("linking_strategy").(string)),
+		},
+		RelyingPartyApplicationId: data.Get("relying_party_application_id").(string),
+		RelyingPartyURL:           data.Get("relying_party_url").(string),
+	}
+
+	o.ApplicationConfiguration = buildHYPRAppConfig("application_configuration", data)
+	o.TenantConfiguration = buildTenantConfiguration(data)
+
+	return o
+}
+
+func buildResourceDataFromIDPHYPR(data *schema.ResourceData, res fusionauth.HYPRIdentityProvider) diag.Diagnostics {
+	if err := data.Set("debug", res.Debug); err != nil {
+		return diag.Errorf("idpHYPR.debug: %s", err.Error())
+	}
+	if err := data.Set("enabled", res.Enabled); err != nil {
+		return diag.Errorf("idpHYPR.enabled: %s", err.Error())
+	}
+	if err := data.Set("lambda_reconcile_id", res.LambdaConfiguration.ReconcileId); err != nil {
+		return diag.Errorf("idpHYPR.lambda_reconcile_id: %s", err.Error())
+	}
+	if err := data.Set("linking_strategy", res.LinkingStrategy); err != nil {
+		return diag.Errorf("idpHYPR.linking_strategy: %s", err.Error())
+	}
+	if err := data.Set("relying_party_application_id", res.RelyingPartyApplicationId); err != nil {
+		return diag.Errorf("idpHYPR.relying_party_application_id: %s", err.Error())
+	}
+	if err := data.Set("relying_party_url", res.RelyingPartyURL); err != nil {
+		return diag.Errorf("idpHYPR.relying_party_url: %s", err.Error())
+	}
+
+	m := decodeApplicationConfiguration[HYPRAppConfig](res.ApplicationConfiguration)
+
+	ac := make([]map[string]interface{}, 0, len(res.ApplicationConfiguration))
+	for k, v := range m {
+		ac = append(ac, map[string]interface{}{
+			"application_id":               k,
+			"create_registration":          v.CreateRegistration,
+			"enabled":                      v.Enabled,
+			"relying_party_application_id": v.RelyingPartyApplicationID,
+			"relying_party_url":            v.RelyingPartyURL,
+		})
+	}
+	if err := data.Set("application_configuration", ac); err != nil {
+		return diag.Errorf("idpHYPR.application_configuration: %s", err.Error())
+	}
+
+	tc := buildTenantConfigurationResource(res.TenantConfiguration)
+	if err := data.Set("tenant_configuration", tc); err != nil {
+		return diag.Errorf("idpHYPR.tenant_configuration: %s", err.Error())
+	}
+
+	return nil
+}
+
+func buildHYPRAppConfig(key string, data *schema.ResourceData) map[string]interface{} {
+	m := make(map[string]interface{})
+	s := data.Get(key)
+	set, ok := s.(*schema.Set)
+	if !ok {
+		return m
+	}
+	l := set.List()
+	for _, x := range l {
+		ac := x.(map[string]interface{})
+		aid := ac["application_id"].(string)
+		oc := HYPRAppConfig{
+			CreateRegistration:        ac["create_registration"].(bool),
+			Enabled:                   ac["enabled"].(bool),
+			RelyingPartyApplicationID: ac["relying_party_application_id"].(string),
+			RelyingPartyURL:           ac["relying_party_url"].(string),
+		}
+		m[aid] = oc
+	}
+	return m
+}
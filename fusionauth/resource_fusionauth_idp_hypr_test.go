@@ -0,0 +1,55 @@
+package fusionauth
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIDPHYPR(t *testing.T) {
+	resourceName := randString10()
+	tfResourcePath := fmt.Sprintf("fusionauth_idp_hypr.test_%s", resourceName)
+
+	startRelyingPartyURL, endRelyingPartyURL := "https://hypr.example.com", "https://hypr2.example.com"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("fusionauth_idp_hypr", "/api/identity-provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDPHYPRConfig(resourceName, startRelyingPartyURL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfResourcePath, "relying_party_url", startRelyingPartyURL),
+					resource.TestCheckResourceAttr(tfResourcePath, "enabled", "true"),
+					resource.TestCheckResourceAttr(tfResourcePath, "relying_party_application_id", "relying-party-"+resourceName),
+				),
+			},
+			{
+				Config: testAccIDPHYPRConfig(resourceName, endRelyingPartyURL),
+				Check:  resource.TestCheckResourceAttr(tfResourcePath, "relying_party_url", endRelyingPartyURL),
+			},
+			{
+				Config:             testAccIDPHYPRConfig(resourceName, endRelyingPartyURL),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:            tfResourcePath,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"idp_id"},
+			},
+		},
+	})
+}
+
+func testAccIDPHYPRConfig(resourceName, relyingPartyURL string) string {
+	return fmt.Sprintf(`
+	resource "fusionauth_idp_hypr" "test_%[1]s" {
+		relying_party_url            = "%[2]s"
+		enabled                      = true
+		relying_party_application_id = "relying-party-%[1]s"
+	}
+	`, resourceName, relyingPartyURL)
+}
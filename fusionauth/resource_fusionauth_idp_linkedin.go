@@ -2,7 +2,6 @@ package fusionauth
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,10 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-type LinkedInIdentityProviderBody struct {
-	IdentityProvider fusionauth.LinkedInIdentityProvider `json:"identityProvider"`
-}
-
 type LinkedInAppConfig struct {
 	ButtonText         string `json:"buttonText,omitempty"`
 	ClientID           string `json:"client_id,omitempty"`
@@ -167,67 +162,36 @@ func resourceIDPLinkedIn() *schema.Resource {
 	}
 }
 
-func createIDPLinkedIn(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	linkedInIDP := buildIDPLinkedIn(data)
-	b, err := json.Marshal(linkedInIDP)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := createIdentityProvider(b, client, "")
-	if err != nil {
-		return diag.FromErr(err)
+func createIDPLinkedIn(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := createIdentityProvider(ctx, data, i.(Client), "", buildIDPLinkedIn(data))
+	if diags != nil {
+		return diags
 	}
 
-	err = json.Unmarshal(bb, &linkedInIDP)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	data.SetId(linkedInIDP.IdentityProvider.Id)
-	return buildResourceFromIDPLinkedIn(linkedInIDP.IdentityProvider, data)
+	data.SetId(idp.Id)
+	return buildResourceFromIDPLinkedIn(*idp, data)
 }
 
-func readIDPLinkedIn(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
+func readIDPLinkedIn(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := retrieveIdentityProvider[fusionauth.LinkedInIdentityProvider](ctx, data, i.(Client))
+	if idp == nil {
+		return diags
 	}
 
-	var ipb LinkedInIdentityProviderBody
-	err = json.Unmarshal(b, &ipb)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return buildResourceFromIDPLinkedIn(ipb.IdentityProvider, data)
+	return buildResourceFromIDPLinkedIn(*idp, data)
 }
 
-func updateIDPLinkedIn(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	linkedInIDP := buildIDPLinkedIn(data)
-	b, err := json.Marshal(linkedInIDP)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
+func updateIDPLinkedIn(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := updateIdentityProvider(ctx, data, i.(Client), buildIDPLinkedIn(data))
+	if diags != nil {
+		return diags
 	}
 
-	err = json.Unmarshal(bb, &linkedInIDP)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	data.SetId(linkedInIDP.IdentityProvider.Id)
-	return buildResourceFromIDPLinkedIn(linkedInIDP.IdentityProvider, data)
+	data.SetId(idp.Id)
+	return buildResourceFromIDPLinkedIn(*idp, data)
 }
 
-func buildIDPLinkedIn(data *schema.ResourceData) LinkedInIdentityProviderBody {
+func buildIDPLinkedIn(data *schema.ResourceData) fusionauth.LinkedInIdentityProvider {
 	linkedInIDP := fusionauth.LinkedInIdentityProvider{
 		BaseIdentityProvider: fusionauth.BaseIdentityProvider{
 			Debug:      data.Get("debug").(bool),
@@ -247,9 +211,7 @@ func buildIDPLinkedIn(data *schema.ResourceData) LinkedInIdentityProviderBody {
 	// Compute application specific configuration and overrides.
 	linkedInIDP.ApplicationConfiguration = buildLinkedInAppConfig("application_configuration", data)
 	linkedInIDP.TenantConfiguration = buildTenantConfiguration(data)
-	return LinkedInIdentityProviderBody{
-		IdentityProvider: linkedInIDP,
-	}
+	return linkedInIDP
 }
 
 // buildLinkedInAppConfig transforms the incoming application configuration as
@@ -307,11 +269,7 @@ func buildResourceFromIDPLinkedIn(res fusionauth.LinkedInIdentityProvider, data
 		return diag.Errorf("idpLinkedIn.scope: %s", err.Error())
 	}
 
-	// Since this is coming down as an interface and would end up being map[string]interface{}
-	// with one of the values being map[string]interface{}
-	b, _ := json.Marshal(res.ApplicationConfiguration)
-	m := make(map[string]LinkedInAppConfig)
-	_ = json.Unmarshal(b, &m)
+	m := decodeApplicationConfiguration[LinkedInAppConfig](res.ApplicationConfiguration)
 
 	ac := make([]map[string]interface{}, 0, len(res.ApplicationConfiguration))
 	for k, v := range m {
@@ -0,0 +1,58 @@
+package fusionauth
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIDPNintendo(t *testing.T) {
+	resourceName := randString10()
+	tfResourcePath := fmt.Sprintf("fusionauth_idp_nintendo.test_%s", resourceName)
+
+	startButtonText, endButtonText := "Login with Nintendo", "Sign in with Nintendo"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("fusionauth_idp_nintendo", "/api/identity-provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDPNintendoConfig(resourceName, startButtonText),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfResourcePath, "button_text", startButtonText),
+					resource.TestCheckResourceAttr(tfResourcePath, "enabled", "true"),
+					resource.TestCheckResourceAttr(tfResourcePath, "client_id", "client-id-"+resourceName),
+					resource.TestCheckResourceAttr(tfResourcePath, "email_claim", "email"),
+					resource.TestCheckResourceAttr(tfResourcePath, "unique_id_claim", "id"),
+				),
+			},
+			{
+				Config: testAccIDPNintendoConfig(resourceName, endButtonText),
+				Check:  resource.TestCheckResourceAttr(tfResourcePath, "button_text", endButtonText),
+			},
+			{
+				Config:             testAccIDPNintendoConfig(resourceName, endButtonText),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:            tfResourcePath,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"idp_id"},
+			},
+		},
+	})
+}
+
+func testAccIDPNintendoConfig(resourceName, buttonText string) string {
+	return fmt.Sprintf(`
+	resource "fusionauth_idp_nintendo" "test_%[1]s" {
+		button_text   = "%[2]s"
+		enabled       = true
+		client_id     = "client-id-%[1]s"
+		client_secret = "client-secret"
+	}
+	`, resourceName, buttonText)
+}
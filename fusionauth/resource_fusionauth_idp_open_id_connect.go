@@ -2,7 +2,6 @@ package fusionauth
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,10 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-type OpenIDConnectIdentityProviderBody struct {
-	IdentityProvider fusionauth.OpenIdConnectIdentityProvider `json:"identityProvider"`
-}
-
 type OpenIDAppConfig struct {
 	ButtonImageURL     string          `json:"buttonImageURL,omitempty"`
 	ButtonText         string          `json:"buttonText,omitempty"`
@@ -252,7 +247,7 @@ func newIDPOpenIDConnect() *schema.Resource {
 	}
 }
 
-func buildOpenIDConnect(data *schema.ResourceData) OpenIDConnectIdentityProviderBody {
+func buildOpenIDConnect(data *schema.ResourceData) fusionauth.OpenIdConnectIdentityProvider {
 	o := fusionauth.OpenIdConnectIdentityProvider{
 		ButtonImageURL: data.Get("button_image_url").(string),
 		ButtonText:     data.Get("button_text").(string),
@@ -289,7 +284,7 @@ func buildOpenIDConnect(data *schema.ResourceData) OpenIDConnectIdentityProvider
 	o.ApplicationConfiguration = buildOpenIDAppConfig("application_configuration", data)
 	o.TenantConfiguration = buildTenantConfiguration(data)
 
-	return OpenIDConnectIdentityProviderBody{IdentityProvider: o}
+	return o
 }
 
 func buildOpenIDAppConfig(key string, data *schema.ResourceData) map[string]interface{} {
@@ -319,40 +314,23 @@ func buildOpenIDAppConfig(key string, data *schema.ResourceData) map[string]inte
 	return m
 }
 
-func createOpenIDConnect(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildOpenIDConnect(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := createIdentityProvider(b, client, data.Get("idp_id").(string))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func createOpenIDConnect(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := createIdentityProvider(ctx, data, i.(Client), data.Get("idp_id").(string), buildOpenIDConnect(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
 
-func readOpenIDConnect(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
+func readOpenIDConnect(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := retrieveIdentityProvider[fusionauth.OpenIdConnectIdentityProvider](ctx, data, i.(Client))
+	if idp == nil {
+		return diags
 	}
 
-	var ipb OpenIDConnectIdentityProviderBody
-	_ = json.Unmarshal(b, &ipb)
-
-	return buildResourceFromOpenIDConnect(ipb.IdentityProvider, data)
+	return buildResourceFromOpenIDConnect(*idp, data)
 }
 
 func buildResourceFromOpenIDConnect(o fusionauth.OpenIdConnectIdentityProvider, data *schema.ResourceData) diag.Diagnostics {
@@ -417,11 +395,7 @@ func buildResourceFromOpenIDConnect(o fusionauth.OpenIdConnectIdentityProvider,
 		return diag.Errorf("idpOpenIDConnect.post_request: %s", err.Error())
 	}
 
-	// Since this is coming down as an interface and would end up being map[string]interface{}
-	// with one of the values being map[string]interface{}
-	b, _ := json.Marshal(o.ApplicationConfiguration)
-	m := make(map[string]OpenIDAppConfig)
-	_ = json.Unmarshal(b, &m)
+	m := decodeApplicationConfiguration[OpenIDAppConfig](o.ApplicationConfiguration)
 
 	ac := make([]map[string]interface{}, 0, len(o.ApplicationConfiguration))
 	for k, v := range m {
@@ -448,25 +422,12 @@ func buildResourceFromOpenIDConnect(o fusionauth.OpenIdConnectIdentityProvider,
 	return nil
 }
 
-func updateOpenIDConnect(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildOpenIDConnect(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func updateOpenIDConnect(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := updateIdentityProvider(ctx, data, i.(Client), buildOpenIDConnect(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
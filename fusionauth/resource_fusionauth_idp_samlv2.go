@@ -2,7 +2,6 @@ package fusionauth
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,10 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-type SAMLIdentityProviderBody struct {
-	IdentityProvider fusionauth.SAMLv2IdentityProvider `json:"identityProvider"`
-}
-
 type SAMLAppConfig struct {
 	ButtonImageURL     string `json:"buttonImageURL,omitempty"`
 	ButtonText         string `json:"buttonText,omitempty"`
@@ -221,65 +216,35 @@ func resourceIDPSAMLv2() *schema.Resource {
 	}
 }
 
-func createIDPSAMLv2(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPSAMLv2(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := createIdentityProvider(b, client, data.Get("idp_id").(string))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func createIDPSAMLv2(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := createIdentityProvider(ctx, data, i.(Client), data.Get("idp_id").(string), buildIDPSAMLv2(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
-func readIDPSAMLv2(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
+func readIDPSAMLv2(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := retrieveIdentityProvider[fusionauth.SAMLv2IdentityProvider](ctx, data, i.(Client))
+	if idp == nil {
+		return diags
 	}
 
-	var ipb SAMLIdentityProviderBody
-	_ = json.Unmarshal(b, &ipb)
-
-	return buildResourceDataFromIDPSAMLv2(data, ipb.IdentityProvider)
+	return buildResourceDataFromIDPSAMLv2(data, *idp)
 }
 
-func updateIDPSAMLv2(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPSAMLv2(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func updateIDPSAMLv2(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := updateIdentityProvider(ctx, data, i.(Client), buildIDPSAMLv2(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
 
-func buildIDPSAMLv2(data *schema.ResourceData) SAMLIdentityProviderBody {
+func buildIDPSAMLv2(data *schema.ResourceData) fusionauth.SAMLv2IdentityProvider {
 	s := fusionauth.SAMLv2IdentityProvider{
 		ButtonImageURL: data.Get("button_image_url").(string),
 		ButtonText:     data.Get("button_text").(string),
@@ -311,7 +276,7 @@ func buildIDPSAMLv2(data *schema.ResourceData) SAMLIdentityProviderBody {
 	s.ApplicationConfiguration = buildSAMLv2AppConfig("application_configuration", data)
 	s.TenantConfiguration = buildTenantConfiguration(data)
 
-	return SAMLIdentityProviderBody{IdentityProvider: s}
+	return s
 }
 func buildResourceDataFromIDPSAMLv2(data *schema.ResourceData, res fusionauth.SAMLv2IdentityProvider) diag.Diagnostics {
 	if err := data.Set("button_image_url", res.ButtonImageURL); err != nil {
@@ -366,11 +331,7 @@ func buildResourceDataFromIDPSAMLv2(data *schema.ResourceData, res fusionauth.SA
 		return diag.Errorf("idpExternalJwt.linking_strategy: %s", err.Error())
 	}
 
-	// Since this is coming down as an interface and would end up being map[string]interface{}
-	// with one of the values being map[string]interface{}
-	b, _ := json.Marshal(res.ApplicationConfiguration)
-	m := make(map[string]SAMLAppConfig)
-	_ = json.Unmarshal(b, &m)
+	m := decodeApplicationConfiguration[SAMLAppConfig](res.ApplicationConfiguration)
 
 	ac := make([]map[string]interface{}, 0, len(res.ApplicationConfiguration))
 	for k, v := range m {
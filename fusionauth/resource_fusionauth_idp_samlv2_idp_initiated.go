@@ -2,7 +2,6 @@ package fusionauth
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,10 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-type SAMLIDPInitiatedIdentityProviderBody struct {
-	IdentityProvider fusionauth.SAMLv2IdPInitiatedIdentityProvider `json:"identityProvider"`
-}
-
 type SAMLIDPInitiatedAppConfig struct {
 	CreateRegistration bool `json:"createRegistration"`
 	Enabled            bool `json:"enabled"`
@@ -153,66 +148,36 @@ func resourceIDPSAMLv2IdPInitiated() *schema.Resource {
 	}
 }
 
-func createIDPSAMLv2IdPInitiated(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPSAMLv2IdPInitiated(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := createIdentityProvider(b, client, data.Get("idp_id").(string))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func createIDPSAMLv2IdPInitiated(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := createIdentityProvider(ctx, data, i.(Client), data.Get("idp_id").(string), buildIDPSAMLv2IdPInitiated(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
 
-func readIDPSAMLv2IdPInitiated(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
+func readIDPSAMLv2IdPInitiated(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := retrieveIdentityProvider[fusionauth.SAMLv2IdPInitiatedIdentityProvider](ctx, data, i.(Client))
+	if idp == nil {
+		return diags
 	}
 
-	var ipb SAMLIDPInitiatedIdentityProviderBody
-	_ = json.Unmarshal(b, &ipb)
-
-	return buildResourceDataFromIDPSAMLv2IdPInitiated(data, ipb.IdentityProvider)
+	return buildResourceDataFromIDPSAMLv2IdPInitiated(data, *idp)
 }
 
-func updateIDPSAMLv2IdPInitiated(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPSAMLv2IdPInitiated(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func updateIDPSAMLv2IdPInitiated(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := updateIdentityProvider(ctx, data, i.(Client), buildIDPSAMLv2IdPInitiated(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
 
-func buildIDPSAMLv2IdPInitiated(data *schema.ResourceData) SAMLIDPInitiatedIdentityProviderBody {
+func buildIDPSAMLv2IdPInitiated(data *schema.ResourceData) fusionauth.SAMLv2IdPInitiatedIdentityProvider {
 	s := fusionauth.SAMLv2IdPInitiatedIdentityProvider{
 		BaseSAMLv2IdentityProvider: fusionauth.BaseSAMLv2IdentityProvider{
 			BaseIdentityProvider: fusionauth.BaseIdentityProvider{
@@ -234,7 +199,7 @@ func buildIDPSAMLv2IdPInitiated(data *schema.ResourceData) SAMLIDPInitiatedIdent
 	s.ApplicationConfiguration = buildIDPSAMLv2IdPInitiatedAppConfig("application_configuration", data)
 	s.TenantConfiguration = buildTenantConfiguration(data)
 
-	return SAMLIDPInitiatedIdentityProviderBody{IdentityProvider: s}
+	return s
 }
 
 func buildResourceDataFromIDPSAMLv2IdPInitiated(data *schema.ResourceData, res fusionauth.SAMLv2IdPInitiatedIdentityProvider) diag.Diagnostics {
@@ -266,11 +231,7 @@ func buildResourceDataFromIDPSAMLv2IdPInitiated(data *schema.ResourceData, res f
 		return diag.Errorf("idpSAMLv2IdpInitiated.linking_strategy: %s", err.Error())
 	}
 
-	// Since this is coming down as an interface and would end up being map[string]interface{}
-	// with one of the values being map[string]interface{}
-	b, _ := json.Marshal(res.ApplicationConfiguration)
-	m := make(map[string]SAMLIDPInitiatedAppConfig)
-	_ = json.Unmarshal(b, &m)
+	m := decodeApplicationConfiguration[SAMLIDPInitiatedAppConfig](res.ApplicationConfiguration)
 
 	ac := make([]map[string]interface{}, 0, len(res.ApplicationConfiguration))
 	for k, v := range m {
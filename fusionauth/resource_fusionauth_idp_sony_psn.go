@@ -2,7 +2,6 @@ package fusionauth
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,10 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-type SonyPSNConnectIdentityProviderBody struct {
-	IdentityProvider fusionauth.SonyPSNIdentityProvider `json:"identityProvider"`
-}
-
 type SonyPSNAppConfig struct {
 	ButtonText         string `json:"buttonText,omitempty"`
 	ClientID           string `json:"client_id,omitempty"`
@@ -169,66 +164,36 @@ func resourceIDPSonyPSN() *schema.Resource {
 	}
 }
 
-func createIDPSonyPSN(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPSonyPSN(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := createIdentityProvider(b, client, data.Get("idp_id").(string))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func createIDPSonyPSN(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := createIdentityProvider(ctx, data, i.(Client), data.Get("idp_id").(string), buildIDPSonyPSN(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
 
-func readIDPSonyPSN(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
+func readIDPSonyPSN(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := retrieveIdentityProvider[fusionauth.SonyPSNIdentityProvider](ctx, data, i.(Client))
+	if idp == nil {
+		return diags
 	}
 
-	var ipb SonyPSNConnectIdentityProviderBody
-	_ = json.Unmarshal(b, &ipb)
-
-	return buildResourceDataFromIDPSonyPSN(data, ipb.IdentityProvider)
+	return buildResourceDataFromIDPSonyPSN(data, *idp)
 }
 
-func updateIDPSonyPSN(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPSonyPSN(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func updateIDPSonyPSN(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := updateIdentityProvider(ctx, data, i.(Client), buildIDPSonyPSN(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
 
-func buildIDPSonyPSN(data *schema.ResourceData) SonyPSNConnectIdentityProviderBody {
+func buildIDPSonyPSN(data *schema.ResourceData) fusionauth.SonyPSNIdentityProvider {
 	o := fusionauth.SonyPSNIdentityProvider{
 		BaseIdentityProvider: fusionauth.BaseIdentityProvider{
 			Debug:      data.Get("debug").(bool),
@@ -248,7 +213,7 @@ func buildIDPSonyPSN(data *schema.ResourceData) SonyPSNConnectIdentityProviderBo
 	o.ApplicationConfiguration = buildSonyPSNAppConfig("application_configuration", data)
 	o.TenantConfiguration = buildTenantConfiguration(data)
 
-	return SonyPSNConnectIdentityProviderBody{IdentityProvider: o}
+	return o
 }
 
 func buildResourceDataFromIDPSonyPSN(data *schema.ResourceData, res fusionauth.SonyPSNIdentityProvider) diag.Diagnostics {
@@ -277,11 +242,7 @@ func buildResourceDataFromIDPSonyPSN(data *schema.ResourceData, res fusionauth.S
 		return diag.Errorf("idpSonyPSN.scope: %s", err.Error())
 	}
 
-	// Since this is coming down as an interface and would end up being map[string]interface{}
-	// with one of the values being map[string]interface{}
-	b, _ := json.Marshal(res.ApplicationConfiguration)
-	m := make(map[string]SonyPSNAppConfig)
-	_ = json.Unmarshal(b, &m)
+	m := decodeApplicationConfiguration[SonyPSNAppConfig](res.ApplicationConfiguration)
 
 	ac := make([]map[string]interface{}, 0, len(res.ApplicationConfiguration))
 	for k, v := range m {
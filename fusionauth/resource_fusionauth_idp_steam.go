@@ -2,7 +2,6 @@ package fusionauth
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,10 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-type SteamConnectIdentityProviderBody struct {
-	IdentityProvider fusionauth.SteamIdentityProvider `json:"identityProvider"`
-}
-
 type SteamAppConfig struct {
 	ButtonText string `json:"buttonText,omitempty"`
 	ClientID   string `json:"client_id,omitempty"`
@@ -180,66 +175,36 @@ func resourceIDPSteam() *schema.Resource {
 	}
 }
 
-func createIDPSteam(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPSteam(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := createIdentityProvider(b, client, data.Get("idp_id").(string))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func createIDPSteam(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := createIdentityProvider(ctx, data, i.(Client), data.Get("idp_id").(string), buildIDPSteam(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
 
-func readIDPSteam(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
+func readIDPSteam(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := retrieveIdentityProvider[fusionauth.SteamIdentityProvider](ctx, data, i.(Client))
+	if idp == nil {
+		return diags
 	}
 
-	var ipb SteamConnectIdentityProviderBody
-	_ = json.Unmarshal(b, &ipb)
-
-	return buildResourceDataFromIDPSteam(data, ipb.IdentityProvider)
+	return buildResourceDataFromIDPSteam(data, *idp)
 }
 
-func updateIDPSteam(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPSteam(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func updateIDPSteam(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := updateIdentityProvider(ctx, data, i.(Client), buildIDPSteam(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
 
-func buildIDPSteam(data *schema.ResourceData) SteamConnectIdentityProviderBody {
+func buildIDPSteam(data *schema.ResourceData) fusionauth.SteamIdentityProvider {
 	o := fusionauth.SteamIdentityProvider{
 		BaseIdentityProvider: fusionauth.BaseIdentityProvider{
 			Debug:      data.Get("debug").(bool),
@@ -260,7 +225,7 @@ func buildIDPSteam(data *schema.ResourceData) SteamConnectIdentityProviderBody {
 	o.ApplicationConfiguration = buildSteamAppConfig("application_configuration", data)
 	o.TenantConfiguration = buildTenantConfiguration(data)
 
-	return SteamConnectIdentityProviderBody{IdentityProvider: o}
+	return o
 }
 
 func buildResourceDataFromIDPSteam(data *schema.ResourceData, res fusionauth.SteamIdentityProvider) diag.Diagnostics {
@@ -289,11 +254,7 @@ func buildResourceDataFromIDPSteam(data *schema.ResourceData, res fusionauth.Ste
 		return diag.Errorf("idpSteam.scope: %s", err.Error())
 	}
 
-	// Since this is coming down as an interface and would end up being map[string]interface{}
-	// with one of the values being map[string]interface{}
-	b, _ := json.Marshal(res.ApplicationConfiguration)
-	m := make(map[string]SteamAppConfig)
-	_ = json.Unmarshal(b, &m)
+	m := decodeApplicationConfiguration[SteamAppConfig](res.ApplicationConfiguration)
 
 	ac := make([]map[string]interface{}, 0, len(res.ApplicationConfiguration))
 	for k, v := range m {
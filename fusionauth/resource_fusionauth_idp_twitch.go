@@ -2,7 +2,6 @@ package fusionauth
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,10 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-type TwitchConnectIdentityProviderBody struct {
-	IdentityProvider fusionauth.TwitchIdentityProvider `json:"identityProvider"`
-}
-
 type TwitchAppConfig struct {
 	ButtonText         string `json:"buttonText,omitempty"`
 	ClientID           string `json:"client_id,omitempty"`
@@ -169,66 +164,36 @@ func resourceIDPTwitch() *schema.Resource {
 	}
 }
 
-func createIDPTwitch(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPTwitch(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := createIdentityProvider(b, client, data.Get("idp_id").(string))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func createIDPTwitch(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := createIdentityProvider(ctx, data, i.(Client), data.Get("idp_id").(string), buildIDPTwitch(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
 
-func readIDPTwitch(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
+func readIDPTwitch(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := retrieveIdentityProvider[fusionauth.TwitchIdentityProvider](ctx, data, i.(Client))
+	if idp == nil {
+		return diags
 	}
 
-	var ipb TwitchConnectIdentityProviderBody
-	_ = json.Unmarshal(b, &ipb)
-
-	return buildResourceDataFromIDPTwitch(data, ipb.IdentityProvider)
+	return buildResourceDataFromIDPTwitch(data, *idp)
 }
 
-func updateIDPTwitch(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPTwitch(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func updateIDPTwitch(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := updateIdentityProvider(ctx, data, i.(Client), buildIDPTwitch(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
 
-func buildIDPTwitch(data *schema.ResourceData) TwitchConnectIdentityProviderBody {
+func buildIDPTwitch(data *schema.ResourceData) fusionauth.TwitchIdentityProvider {
 	o := fusionauth.TwitchIdentityProvider{
 		BaseIdentityProvider: fusionauth.BaseIdentityProvider{
 			Debug:      data.Get("debug").(bool),
@@ -248,7 +213,7 @@ func buildIDPTwitch(data *schema.ResourceData) TwitchConnectIdentityProviderBody
 	o.ApplicationConfiguration = buildTwitchAppConfig("application_configuration", data)
 	o.TenantConfiguration = buildTenantConfiguration(data)
 
-	return TwitchConnectIdentityProviderBody{IdentityProvider: o}
+	return o
 }
 
 func buildResourceDataFromIDPTwitch(data *schema.ResourceData, res fusionauth.TwitchIdentityProvider) diag.Diagnostics {
@@ -277,11 +242,7 @@ func buildResourceDataFromIDPTwitch(data *schema.ResourceData, res fusionauth.Tw
 		return diag.Errorf("idpTwitch.scope: %s", err.Error())
 	}
 
-	// Since this is coming down as an interface and would end up being map[string]interface{}
-	// with one of the values being map[string]interface{}
-	b, _ := json.Marshal(res.ApplicationConfiguration)
-	m := make(map[string]TwitchAppConfig)
-	_ = json.Unmarshal(b, &m)
+	m := decodeApplicationConfiguration[TwitchAppConfig](res.ApplicationConfiguration)
 
 	ac := make([]map[string]interface{}, 0, len(res.ApplicationConfiguration))
 	for k, v := range m {
@@ -0,0 +1,278 @@
+package fusionauth
+
+import (
+	"context"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type TwitterAppConfig struct {
+	ButtonText         string `json:"buttonText,omitempty"`
+	ConsumerKey        string `json:"consumerKey,omitempty"`
+	ConsumerSecret     string `json:"consumerSecret,omitempty"`
+	CreateRegistration bool   `json:"createRegistration"`
+	Enabled            bool   `json:"enabled"`
+}
+
+func resourceIDPTwitter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createIDPTwitter,
+		ReadContext:   readIDPTwitter,
+		UpdateContext: updateIDPTwitter,
+		DeleteContext: deleteIdentityProvider,
+		Schema: map[string]*schema.Schema{
+			"idp_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The ID to use for the new identity provider. If not specified a secure random UUID will be generated.",
+				ValidateFunc: validation.IsUUID,
+				ForceNew:     true,
+			},
+			"application_configuration": {
+				Optional:    true,
+				Type:        schema.TypeSet,
+				Description: "The configuration for each Application that the identity provider is enabled for.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"button_text": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "This is an optional Application specific override for the top level button text.",
+						},
+						"consumer_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "This is an optional Application specific override for the top level consumer_key.",
+						},
+						"consumer_secret": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "This is an optional Application specific override for the top level consumer_secret.",
+						},
+						"create_registration": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Determines if a UserRegistration is created for the User automatically or not. If a user doesn’t exist in FusionAuth and logs in through an identity provider, this boolean controls whether or not FusionAuth creates a registration for the User in the Application they are logging into.",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Determines if this identity provider is enabled for the Application specified by the applicationId key.",
+						},
+					},
+				},
+			},
+			"button_text": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The top-level button text to use on the FusionAuth login page for this Identity Provider.",
+			},
+			"consumer_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Twitter Consumer API key. This value is retrieved from the Twitter developer portal when you setup your Twitter developer account, where it is called the API key.",
+			},
+			"consumer_secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The Twitter Consumer API secret. This value is retrieved from the Twitter developer portal when you setup your Twitter developer account, where it is called the API key secret.",
+			},
+			"debug": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if debug is enabled for this provider. When enabled, each time this provider is invoked to reconcile a login an Event Log will be created.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if this provider is enabled. If it is false then it will be disabled globally.",
+			},
+			"lambda_reconcile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The unique Id of the lambda to used during the user reconcile process to map custom claims from the external identity provider to the FusionAuth user.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"linking_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"CreatePendingLink",
+					"LinkAnonymously",
+					"LinkByEmail",
+					"LinkByEmailForExistingUser",
+					"LinkByUsername",
+					"LinkByUsernameForExistingUser",
+					"Unsupported",
+				}, false),
+				Description: "The linking strategy to use when creating the link between the Twitter Identity Provider and the user.",
+			},
+			"tenant_configuration": {
+				Optional:    true,
+				Type:        schema.TypeSet,
+				Description: "The configuration for each Tenant that limits the number of links a user may have for a particular identity provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tenant_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"limit_user_link_count_enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "When enabled, the number of identity provider links a user may create is enforced by maximumLinks",
+						},
+						"limit_user_link_count_maximum_links": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     42,
+							Description: "The maximum number of links a user may have for this identity provider.",
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func createIDPTwitter(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := createIdentityProvider(ctx, data, i.(Client), data.Get("idp_id").(string), buildIDPTwitter(data))
+	if diags != nil {
+		return diags
+	}
+
+	data.SetId(idp.Id)
+	return nil
+}
+
+func readIDPTwitter(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := retrieveIdentityProvider[fusionauth.TwitterIdentityProvider](ctx, data, i.(Client))
+	if idp == nil {
+		return diags
+	}
+
+	return buildResourceDataFromIDPTwitter(data, *idp)
+}
+
+func updateIDPTwitter(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := updateIdentityProvider(ctx, data, i.(Client), buildIDPTwitter(data))
+	if diags != nil {
+		return diags
+	}
+
+	data.SetId(idp.Id)
+	return nil
+}
+
+func buildIDPTwitter(data *schema.ResourceData) fusionauth.TwitterIdentityProvider {
+	o := fusionauth.TwitterIdentityProvider{
+		BaseIdentityProvider: fusionauth.BaseIdentityProvider{
+			Debug:      data.Get("debug").(bool),
+			Enableable: buildEnableable("enabled", data),
+			LambdaConfiguration: fusionauth.ProviderLambdaConfiguration{
+				ReconcileId: data.Get("lambda_reconcile_id").(string),
+			},
+			Type:            fusionauth.IdentityProviderType_Twitter,
+			LinkingStrategy: fusionauth.IdentityProviderLinkingStrategy(data.Get("linking_strategy").(string)),
+		},
+		ButtonText:     data.Get("button_text").(string),
+		ConsumerKey:    data.Get("consumer_key").(string),
+		ConsumerSecret: data.Get("consumer_secret").(string),
+	}
+
+	o.ApplicationConfiguration = buildTwitterAppConfig("application_configuration", data)
+	o.TenantConfiguration = buildTenantConfiguration(data)
+
+	return o
+}
+
+func buildResourceDataFromIDPTwitter(data *schema.ResourceData, res fusionauth.TwitterIdentityProvider) diag.Diagnostics {
+	if err := data.Set("button_text", res.ButtonText); err != nil {
+		return diag.Errorf("idpTwitter.button_text: %s", err.Error())
+	}
+	if err := data.Set("consumer_key", res.ConsumerKey); err != nil {
+		return diag.Errorf("idpTwitter.consumer_key: %s", err.Error())
+	}
+	if err := data.Set("consumer_secret", res.ConsumerSecret); err != nil {
+		return diag.Errorf("idpTwitter.consumer_secret: %s", err.Error())
+	}
+	if err := data.Set("debug", res.Debug); err != nil {
+		return diag.Errorf("idpTwitter.debug: %s", err.Error())
+	}
+	if err := data.Set("enabled", res.Enabled); err != nil {
+		return diag.Errorf("idpTwitter.enabled: %s", err.Error())
+	}
+	if err := data.Set("lambda_reconcile_id", res.LambdaConfiguration.ReconcileId); err != nil {
+		return diag.Errorf("idpTwitter.lambda_reconcile_id: %s", err.Error())
+	}
+	if err := data.Set("linking_strategy", res.LinkingStrategy); err != nil {
+		return diag.Errorf("idpTwitter.linking_strategy: %s", err.Error())
+	}
+
+	m := decodeApplicationConfiguration[TwitterAppConfig](res.ApplicationConfiguration)
+
+	ac := make([]map[string]interface{}, 0, len(res.ApplicationConfiguration))
+	for k, v := range m {
+		ac = append(ac, map[string]interface{}{
+			"application_id":      k,
+			"button_text":         v.ButtonText,
+			"consumer_key":        v.ConsumerKey,
+			"consumer_secret":     v.ConsumerSecret,
+			"create_registration": v.CreateRegistration,
+			"enabled":             v.Enabled,
+		})
+	}
+	if err := data.Set("application_configuration", ac); err != nil {
+		return diag.Errorf("idpTwitter.application_configuration: %s", err.Error())
+	}
+
+	tc := buildTenantConfigurationResource(res.TenantConfiguration)
+	if err := data.Set("tenant_configuration", tc); err != nil {
+		return diag.Errorf("idpTwitter.tenant_configuration: %s", err.Error())
+	}
+
+	return nil
+}
+
+func buildTwitterAppConfig(key string, data *schema.ResourceData) map[string]interface{} {
+	m := make(map[string]interface{})
+	s := data.Get(key)
+	set, ok := s.(*schema.Set)
+	if !ok {
+		return m
+	}
+	l := set.List()
+	for _, x := range l {
+		ac := x.(map[string]interface{})
+		aid := ac["application_id"].(string)
+		oc := TwitterAppConfig{
+			ButtonText:         ac["button_text"].(string),
+			ConsumerKey:        ac["consumer_key"].(string),
+			ConsumerSecret:     ac["consumer_secret"].(string),
+			CreateRegistration: ac["create_registration"].(bool),
+			Enabled:            ac["enabled"].(bool),
+		}
+		m[aid] = oc
+	}
+	return m
+}
@@ -0,0 +1,56 @@
+package fusionauth
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIDPTwitter(t *testing.T) {
+	resourceName := randString10()
+	tfResourcePath := fmt.Sprintf("fusionauth_idp_twitter.test_%s", resourceName)
+
+	startButtonText, endButtonText := "Login with Twitter", "Sign in with Twitter"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("fusionauth_idp_twitter", "/api/identity-provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDPTwitterConfig(resourceName, startButtonText),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfResourcePath, "button_text", startButtonText),
+					resource.TestCheckResourceAttr(tfResourcePath, "enabled", "true"),
+					resource.TestCheckResourceAttr(tfResourcePath, "consumer_key", "consumer-key-"+resourceName),
+				),
+			},
+			{
+				Config: testAccIDPTwitterConfig(resourceName, endButtonText),
+				Check:  resource.TestCheckResourceAttr(tfResourcePath, "button_text", endButtonText),
+			},
+			{
+				Config:             testAccIDPTwitterConfig(resourceName, endButtonText),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:            tfResourcePath,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"idp_id"},
+			},
+		},
+	})
+}
+
+func testAccIDPTwitterConfig(resourceName, buttonText string) string {
+	return fmt.Sprintf(`
+	resource "fusionauth_idp_twitter" "test_%[1]s" {
+		button_text     = "%[2]s"
+		enabled         = true
+		consumer_key    = "consumer-key-%[1]s"
+		consumer_secret = "consumer-secret"
+	}
+	`, resourceName, buttonText)
+}
@@ -2,7 +2,6 @@ package fusionauth
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,10 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-type XboxConnectIdentityProviderBody struct {
-	IdentityProvider fusionauth.XboxIdentityProvider `json:"identityProvider"`
-}
-
 type XboxAppConfig struct {
 	ButtonText         string `json:"buttonText,omitempty"`
 	ClientID           string `json:"client_id,omitempty"`
@@ -169,66 +164,36 @@ func resourceIDPXbox() *schema.Resource {
 	}
 }
 
-func createIDPXbox(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPXbox(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := createIdentityProvider(b, client, data.Get("idp_id").(string))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func createIDPXbox(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := createIdentityProvider(ctx, data, i.(Client), data.Get("idp_id").(string), buildIDPXbox(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
 
-func readIDPXbox(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	b, err := readIdentityProvider(data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
+func readIDPXbox(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := retrieveIdentityProvider[fusionauth.XboxIdentityProvider](ctx, data, i.(Client))
+	if idp == nil {
+		return diags
 	}
 
-	var ipb XboxConnectIdentityProviderBody
-	_ = json.Unmarshal(b, &ipb)
-
-	return buildResourceDataFromIDPXbox(data, ipb.IdentityProvider)
+	return buildResourceDataFromIDPXbox(data, *idp)
 }
 
-func updateIDPXbox(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	o := buildIDPXbox(data)
-
-	b, err := json.Marshal(o)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	client := i.(Client)
-	bb, err := updateIdentityProvider(b, data.Id(), client)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = json.Unmarshal(bb, &o)
-	if err != nil {
-		return diag.FromErr(err)
+func updateIDPXbox(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	idp, diags := updateIdentityProvider(ctx, data, i.(Client), buildIDPXbox(data))
+	if diags != nil {
+		return diags
 	}
 
-	data.SetId(o.IdentityProvider.Id)
+	data.SetId(idp.Id)
 	return nil
 }
 
-func buildIDPXbox(data *schema.ResourceData) XboxConnectIdentityProviderBody {
+func buildIDPXbox(data *schema.ResourceData) fusionauth.XboxIdentityProvider {
 	o := fusionauth.XboxIdentityProvider{
 		BaseIdentityProvider: fusionauth.BaseIdentityProvider{
 			Debug:      data.Get("debug").(bool),
@@ -248,7 +213,7 @@ func buildIDPXbox(data *schema.ResourceData) XboxConnectIdentityProviderBody {
 	o.ApplicationConfiguration = buildXboxAppConfig("application_configuration", data)
 	o.TenantConfiguration = buildTenantConfiguration(data)
 
-	return XboxConnectIdentityProviderBody{IdentityProvider: o}
+	return o
 }
 
 func buildResourceDataFromIDPXbox(data *schema.ResourceData, res fusionauth.XboxIdentityProvider) diag.Diagnostics {
@@ -277,11 +242,7 @@ func buildResourceDataFromIDPXbox(data *schema.ResourceData, res fusionauth.Xbox
 		return diag.Errorf("idpXbox.scope: %s", err.Error())
 	}
 
-	// Since this is coming down as an interface and would end up being map[string]interface{}
-	// with one of the values being map[string]interface{}
-	b, _ := json.Marshal(res.ApplicationConfiguration)
-	m := make(map[string]XboxAppConfig)
-	_ = json.Unmarshal(b, &m)
+	m := decodeApplicationConfiguration[XboxAppConfig](res.ApplicationConfiguration)
 
 	ac := make([]map[string]interface{}, 0, len(res.ApplicationConfiguration))
 	for k, v := range m {
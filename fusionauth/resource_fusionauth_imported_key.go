@@ -12,11 +12,11 @@ import (
 func resourceImportedKey() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: createImportedKey,
-		ReadContext: func(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-			return keyRead(data, buildResourceDataFromImportedKey, i)
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			return keyRead(ctx, data, buildResourceDataFromImportedKey, i)
 		},
-		UpdateContext: func(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-			return keyUpdate(data, buildImportedKey, i)
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			return keyUpdate(ctx, data, buildImportedKey, i)
 		},
 		DeleteContext: keyDelete,
 		Schema: map[string]*schema.Schema{
@@ -106,7 +106,7 @@ func resourceImportedKey() *schema.Resource {
 	}
 }
 
-func createImportedKey(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createImportedKey(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	l := buildImportedKey(data)
 
@@ -115,14 +115,14 @@ func createImportedKey(_ context.Context, data *schema.ResourceData, i interface
 		keyID = a.(string)
 	}
 
-	resp, faErrs, err := client.FAClient.ImportKey(keyID, fusionauth.KeyRequest{
+	resp, faErrs, err := client.FAClient.ImportKeyWithContext(ctx, keyID, fusionauth.KeyRequest{
 		Key: l,
 	})
 	if err != nil {
 		return diag.Errorf("CreateKey err: %v", err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	data.SetId(resp.Key.Id)
@@ -0,0 +1,243 @@
+package fusionauth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceIntegrations() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createIntegrations,
+		ReadContext:   readIntegrations,
+		UpdateContext: updateIntegrations,
+		DeleteContext: deleteIntegrations,
+		Schema: map[string]*schema.Schema{
+			"cleanspeak": {
+				Type:       schema.TypeList,
+				MaxItems:   1,
+				Optional:   true,
+				Computed:   true,
+				ConfigMode: schema.SchemaConfigModeAttr,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "The CleanSpeak API key.",
+						},
+						"application_ids": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsUUID},
+							Description: "The CleanSpeak application Ids that are used to filter usernames and other content.",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether or not the CleanSpeak integration is enabled.",
+						},
+						"url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							Description:  "The URL of the CleanSpeak API.",
+						},
+						"username_moderation": {
+							Type:       schema.TypeList,
+							MaxItems:   1,
+							Optional:   true,
+							Computed:   true,
+							ConfigMode: schema.SchemaConfigModeAttr,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsUUID,
+										Description:  "The Id of the CleanSpeak application that usernames are sent to for moderation.",
+									},
+									"enabled": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     false,
+										Description: "Whether or not CleanSpeak username moderation is enabled.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"kafka": {
+				Type:       schema.TypeList,
+				MaxItems:   1,
+				Optional:   true,
+				Computed:   true,
+				ConfigMode: schema.SchemaConfigModeAttr,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_topic": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "fusionauth",
+							Description: "The default Kafka topic events are sent to.",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether or not the Kafka integration is enabled.",
+						},
+						"producer": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Computed:    true,
+							Sensitive:   true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The Kafka producer configuration, i.e. bootstrap.servers. The values may hold credentials, such as sasl.jaas.config, so they are not shown in plans.",
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func createIntegrations(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	data.SetId("integrations")
+	return updateIntegrations(ctx, data, i)
+}
+
+func readIntegrations(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	resp, err := client.FAClient.RetrieveIntegrationWithContext(ctx)
+	if err != nil {
+		return diag.Errorf("RetrieveIntegration err: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		data.SetId("")
+		return nil
+	}
+	if err := checkResponse(resp.StatusCode, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return buildResourceDataFromIntegrations(resp.Integrations, data)
+}
+
+func updateIntegrations(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	resp, faErrs, err := client.FAClient.UpdateIntegrationsWithContext(ctx, buildIntegrationRequest(data))
+	if err != nil {
+		return diag.Errorf("UpdateIntegrations err: %v", err)
+	}
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	return buildResourceDataFromIntegrations(resp.Integrations, data)
+}
+
+// deleteIntegrations resets the integrations to the defaults of a new
+// FusionAuth instance, as they cannot be deleted.
+func deleteIntegrations(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	resp, faErrs, err := client.FAClient.UpdateIntegrationsWithContext(ctx, getDefaultIntegrationRequest())
+	if err != nil {
+		return diag.Errorf("UpdateIntegrations err: %v", err)
+	}
+
+	return checkResponseDiagnostics(data, resp.StatusCode, faErrs)
+}
+
+func buildIntegrationRequest(data *schema.ResourceData) fusionauth.IntegrationRequest {
+	req := getDefaultIntegrationRequest()
+
+	if _, ok := data.GetOk("cleanspeak.0"); ok {
+		req.Integrations.Cleanspeak = fusionauth.CleanSpeakConfiguration{
+			Enableable:     buildEnableable("cleanspeak.0.enabled", data),
+			ApiKey:         data.Get("cleanspeak.0.api_key").(string),
+			ApplicationIds: handleStringSlice("cleanspeak.0.application_ids", data),
+			Url:            data.Get("cleanspeak.0.url").(string),
+			UsernameModeration: fusionauth.UsernameModeration{
+				Enableable:    buildEnableable("cleanspeak.0.username_moderation.0.enabled", data),
+				ApplicationId: data.Get("cleanspeak.0.username_moderation.0.application_id").(string),
+			},
+		}
+	}
+
+	if _, ok := data.GetOk("kafka.0"); ok {
+		req.Integrations.Kafka.Enabled = data.Get("kafka.0.enabled").(bool)
+		req.Integrations.Kafka.DefaultTopic = data.Get("kafka.0.default_topic").(string)
+		if v, ok := data.GetOk("kafka.0.producer"); ok {
+			req.Integrations.Kafka.Producer = intMapToStringMap(v.(map[string]interface{}))
+		}
+	}
+
+	return req
+}
+
+func buildResourceDataFromIntegrations(in fusionauth.Integrations, data *schema.ResourceData) diag.Diagnostics {
+	apiKey := in.Cleanspeak.ApiKey
+	if apiKey == "" {
+		// FusionAuth does not return the API key, keep the configured one.
+		apiKey = data.Get("cleanspeak.0.api_key").(string)
+	}
+
+	err := data.Set("cleanspeak", []map[string]interface{}{
+		{
+			"api_key":         apiKey,
+			"application_ids": in.Cleanspeak.ApplicationIds,
+			"enabled":         in.Cleanspeak.Enabled,
+			"url":             in.Cleanspeak.Url,
+			"username_moderation": []map[string]interface{}{
+				{
+					"application_id": in.Cleanspeak.UsernameModeration.ApplicationId,
+					"enabled":        in.Cleanspeak.UsernameModeration.Enabled,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return diag.Errorf("integrations.cleanspeak: %s", err.Error())
+	}
+
+	err = data.Set("kafka", []map[string]interface{}{
+		{
+			"default_topic": in.Kafka.DefaultTopic,
+			"enabled":       in.Kafka.Enabled,
+			"producer":      in.Kafka.Producer,
+		},
+	})
+	if err != nil {
+		return diag.Errorf("integrations.kafka: %s", err.Error())
+	}
+
+	return nil
+}
+
+func getDefaultIntegrationRequest() fusionauth.IntegrationRequest {
+	return fusionauth.IntegrationRequest{
+		Integrations: fusionauth.Integrations{
+			Kafka: fusionauth.KafkaConfiguration{
+				DefaultTopic: "fusionauth",
+				Producer: map[string]string{
+					"bootstrap.servers":  "localhost:9092",
+					"max.block.ms":       "5000",
+					"request.timeout.ms": "2000",
+				},
+			},
+		},
+	}
+}
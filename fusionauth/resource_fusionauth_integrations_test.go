@@ -0,0 +1,123 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIntegrations(t *testing.T) {
+	tfResourcePath := "fusionauth_integrations.test"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckIntegrationsReset,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationsConfig("fusionauth"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfResourcePath, "kafka.0.enabled", "false"),
+					resource.TestCheckResourceAttr(tfResourcePath, "kafka.0.default_topic", "fusionauth"),
+					resource.TestCheckResourceAttr(tfResourcePath, "kafka.0.producer.bootstrap.servers", "kafka:9092"),
+				),
+			},
+			{
+				Config: testAccIntegrationsConfig("fusionauth-events"),
+				Check:  resource.TestCheckResourceAttr(tfResourcePath, "kafka.0.default_topic", "fusionauth-events"),
+			},
+			{
+				Config:             testAccIntegrationsConfig("fusionauth-events"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:      tfResourcePath,
+				ImportState:       true,
+				ImportStateId:     "integrations",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIntegrationsConfig(defaultTopic string) string {
+	return fmt.Sprintf(`
+	resource "fusionauth_integrations" "test" {
+		kafka = [{
+			default_topic = "%s"
+			enabled       = false
+			producer = {
+				"bootstrap.servers" = "kafka:9092"
+			}
+		}]
+	}
+	`, defaultTopic)
+}
+
+// testAccCheckIntegrationsReset checks that destroying the integrations reset
+// them to the defaults of a new FusionAuth instance.
+func testAccCheckIntegrationsReset(*terraform.State) error {
+	client := fusionauthClient()
+	resp, err := client.RetrieveIntegrationWithContext(context.Background())
+	if err != nil {
+		return err
+	}
+
+	kafka := resp.Integrations.Kafka
+	if kafka.DefaultTopic != "fusionauth" || kafka.Producer["bootstrap.servers"] != "localhost:9092" {
+		return fmt.Errorf("kafka integration not reset: %#v", kafka)
+	}
+
+	return nil
+}
+
+func Test_integrations_deleteResetsToDefaults(t *testing.T) {
+	fake, client := newFakeClient(t)
+	r := resourceIntegrations()
+
+	applicationID := fake.create("application", map[string]interface{}{"name": "App"})
+	data := applyConfig(t, r, map[string]interface{}{
+		"cleanspeak": []interface{}{map[string]interface{}{
+			"api_key":         "cleanspeak-api-key",
+			"application_ids": []interface{}{applicationID},
+			"enabled":         true,
+			"url":             "https://cleanspeak.example.com",
+			"username_moderation": []interface{}{map[string]interface{}{
+				"application_id": applicationID,
+				"enabled":        true,
+			}},
+		}},
+		"kafka": []interface{}{map[string]interface{}{
+			"default_topic": "events",
+			"enabled":       true,
+			"producer":      map[string]interface{}{"bootstrap.servers": "kafka:9092"},
+		}},
+	}, client)
+
+	if diags := deleteIntegrations(context.Background(), data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	reset := r.Data(nil)
+	if diags := readIntegrations(context.Background(), reset, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	for k, want := range map[string]interface{}{
+		"cleanspeak.0.enabled":                       false,
+		"cleanspeak.0.url":                           "",
+		"cleanspeak.0.application_ids.#":             0,
+		"cleanspeak.0.username_moderation.0.enabled": false,
+		"kafka.0.default_topic":                      "fusionauth",
+		"kafka.0.enabled":                            false,
+	} {
+		if got := reset.Get(k); got != want {
+			t.Errorf("%s = %#v after delete, want %#v", k, got, want)
+		}
+	}
+	producer := reset.Get("kafka.0.producer").(map[string]interface{})
+	if got := producer["bootstrap.servers"]; got != "localhost:9092" {
+		t.Errorf("kafka.0.producer bootstrap.servers = %#v after delete, want %q", got, "localhost:9092")
+	}
+}
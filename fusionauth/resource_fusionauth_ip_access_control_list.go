@@ -0,0 +1,280 @@
+package fusionauth
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ipAccessControlWildcard matches all IP addresses. Every IP access control
+// list needs an entry for it, which is the default action.
+const ipAccessControlWildcard = "*"
+
+func newIPAccessControlList() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createIPAccessControlList,
+		ReadContext:   readIPAccessControlList,
+		UpdateContext: updateIPAccessControlList,
+		DeleteContext: deleteIPAccessControlList,
+		CustomizeDiff: validateIPAccessControlList,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The Id to use for the new IP Access Control List. If not specified a secure random UUID will be generated.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "An object that can hold any information about the IP Access Control List that should be persisted.",
+			},
+			"entry": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The IP ranges and the action to take for requests from them. An entry for * is required, which is the action for all other IP addresses.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(fusionauth.IPAccessControlEntryAction_Allow),
+								string(fusionauth.IPAccessControlEntryAction_Block),
+							}, false),
+							Description: "The action to take for requests from the IP range. The possible values are Allow and Block.",
+						},
+						"end_ip_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateIPAccessControlAddress,
+							Description:  "The last IP address of the range. Defaults to start_ip_address, matching a single IP address.",
+						},
+						"start_ip_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIPAccessControlAddress,
+							Description:  "The first IP address of the range, or * to match all IP addresses.",
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The unique name of the IP Access Control List.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func buildIPAccessControlList(data *schema.ResourceData) fusionauth.IPAccessControlList {
+	acl := fusionauth.IPAccessControlList{
+		Id:   data.Get("id").(string),
+		Data: data.Get("data").(map[string]interface{}),
+		Name: data.Get("name").(string),
+	}
+
+	for _, e := range data.Get("entry").([]interface{}) {
+		entry := e.(map[string]interface{})
+		start, end := entry["start_ip_address"].(string), entry["end_ip_address"].(string)
+		if end == "" {
+			end = start
+		}
+
+		acl.Entries = append(acl.Entries, fusionauth.IPAccessControlEntry{
+			Action:         fusionauth.IPAccessControlEntryAction(entry["action"].(string)),
+			EndIPAddress:   end,
+			StartIPAddress: start,
+		})
+	}
+
+	return acl
+}
+
+func createIPAccessControlList(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	acl := buildIPAccessControlList(data)
+
+	resp, faErrs, err := client.FAClient.CreateIPAccessControlListWithContext(ctx, acl.Id, fusionauth.IPAccessControlListRequest{
+		IpAccessControlList: acl,
+	})
+	if err != nil {
+		return diag.Errorf("CreateIPAccessControlList err: %v", err)
+	}
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	data.SetId(resp.IpAccessControlList.Id)
+	return nil
+}
+
+func readIPAccessControlList(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, err := client.FAClient.RetrieveIPAccessControlListWithContext(ctx, data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		data.SetId("")
+		return nil
+	}
+	if err := checkResponse(resp.StatusCode, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	acl := resp.IpAccessControlList
+	if err := data.Set("data", acl.Data); err != nil {
+		return diag.Errorf("ip_access_control_list.data: %s", err.Error())
+	}
+
+	entries := make([]map[string]interface{}, 0, len(acl.Entries))
+	for i, e := range acl.Entries {
+		// FusionAuth stores the start address as the end of a single address
+		// entry, which is configured without an end address.
+		end := e.EndIPAddress
+		if end == e.StartIPAddress && data.Get(fmt.Sprintf("entry.%d.end_ip_address", i)).(string) == "" {
+			end = ""
+		}
+
+		entries = append(entries, map[string]interface{}{
+			"action":           e.Action,
+			"end_ip_address":   end,
+			"start_ip_address": e.StartIPAddress,
+		})
+	}
+	if err := data.Set("entry", entries); err != nil {
+		return diag.Errorf("ip_access_control_list.entry: %s", err.Error())
+	}
+	if err := data.Set("name", acl.Name); err != nil {
+		return diag.Errorf("ip_access_control_list.name: %s", err.Error())
+	}
+
+	return nil
+}
+
+func updateIPAccessControlList(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	acl := buildIPAccessControlList(data)
+
+	resp, faErrs, err := client.FAClient.UpdateIPAccessControlListWithContext(ctx, data.Id(), fusionauth.IPAccessControlListRequest{
+		IpAccessControlList: acl,
+	})
+	if err != nil {
+		return diag.Errorf("UpdateIPAccessControlList err: %v", err)
+	}
+
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	return nil
+}
+
+func deleteIPAccessControlList(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, faErrs, err := client.FAClient.DeleteIPAccessControlListWithContext(ctx, data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	return nil
+}
+
+// validateIPAccessControlAddress validates that the value is an IPv4 or IPv6
+// address, or the wildcard.
+func validateIPAccessControlAddress(i interface{}, k string) (warnings []string, errs []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if v != "" && v != ipAccessControlWildcard && net.ParseIP(v) == nil {
+		errs = append(errs, fmt.Errorf("expected %s to be an IP address or %s, got %s", k, ipAccessControlWildcard, v))
+	}
+
+	return warnings, errs
+}
+
+// validateIPAccessControlList validates the IP ranges of the entries at plan
+// time, as FusionAuth would otherwise only reject them on apply.
+func validateIPAccessControlList(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	entries, ok := diff.Get("entry").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	wildcard, unknown := false, false
+	for i, e := range entries {
+		entry, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		start, end := entry["start_ip_address"].(string), entry["end_ip_address"].(string)
+		// Addresses that are not known until apply can't be validated, and
+		// may turn out to be the wildcard.
+		if start == "" {
+			unknown = true
+			continue
+		}
+		if start == ipAccessControlWildcard {
+			wildcard = true
+		}
+
+		if err := validateIPRange(start, end); err != nil {
+			return fmt.Errorf("entry.%d: %w", i, err)
+		}
+	}
+
+	if !wildcard && !unknown {
+		return fmt.Errorf("entry: an entry with start_ip_address %q is required", ipAccessControlWildcard)
+	}
+
+	return nil
+}
+
+// validateIPRange validates that start and end are addresses of the same
+// family and that start is not after end. An empty end matches start only.
+func validateIPRange(start, end string) error {
+	if end == "" || end == start {
+		return nil
+	}
+	if start == ipAccessControlWildcard || end == ipAccessControlWildcard {
+		return fmt.Errorf("the range %s to %s is invalid, %s can only be used for both addresses", start, end, ipAccessControlWildcard)
+	}
+
+	startIP, endIP := net.ParseIP(start), net.ParseIP(end)
+	if startIP == nil || endIP == nil {
+		return fmt.Errorf("the range %s to %s is invalid, both addresses must be IP addresses", start, end)
+	}
+	if (startIP.To4() == nil) != (endIP.To4() == nil) {
+		return fmt.Errorf("the range %s to %s is invalid, both addresses must be IPv4 or IPv6 addresses", start, end)
+	}
+	if bytes.Compare(startIP.To16(), endIP.To16()) > 0 {
+		return fmt.Errorf("the range %s to %s is invalid, the start address is after the end address", start, end)
+	}
+
+	return nil
+}
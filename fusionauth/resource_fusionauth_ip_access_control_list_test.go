@@ -0,0 +1,185 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIPAccessControlList(t *testing.T) {
+	resourceName := randString10()
+	tfResourcePath := fmt.Sprintf("fusionauth_ip_access_control_list.test_%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("fusionauth_ip_access_control_list", "/api/ip-acl"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAccessControlListConfig(resourceName, "10.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfResourcePath, "entry.#", "2"),
+					resource.TestCheckResourceAttr(tfResourcePath, "entry.1.start_ip_address", "10.0.0.1"),
+					resource.TestCheckResourceAttr(tfResourcePath, "entry.1.end_ip_address", ""),
+				),
+			},
+			{
+				Config:             testAccIPAccessControlListConfig(resourceName, "10.0.0.1"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				Config: testAccIPAccessControlListConfig(resourceName, "10.0.0.2"),
+				Check:  resource.TestCheckResourceAttr(tfResourcePath, "entry.1.start_ip_address", "10.0.0.2"),
+			},
+			{
+				ResourceName:      tfResourcePath,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIPAccessControlListConfig(resourceName, allowedIPAddress string) string {
+	return fmt.Sprintf(`
+	resource "fusionauth_ip_access_control_list" "test_%[1]s" {
+		name = "office-%[1]s"
+
+		entry {
+			action           = "Block"
+			start_ip_address = "*"
+		}
+		entry {
+			action           = "Allow"
+			start_ip_address = "%[2]s"
+		}
+	}
+	`, resourceName, allowedIPAddress)
+}
+
+func Test_validateIPRange(t *testing.T) {
+	tests := []struct {
+		start, end string
+		wantErr    bool
+	}{
+		{start: "*", end: "*"},
+		{start: "*", end: ""},
+		{start: "10.0.0.1", end: ""},
+		{start: "10.0.0.1", end: "10.0.0.255"},
+		{start: "10.0.0.1", end: "10.0.0.1"},
+		{start: "2001:db8::1", end: "2001:db8::ffff"},
+		{start: "10.0.1.0", end: "10.0.0.255", wantErr: true},
+		{start: "10.0.0.1", end: "2001:db8::1", wantErr: true},
+		{start: "*", end: "10.0.0.1", wantErr: true},
+		{start: "2001:db8::ffff", end: "2001:db8::1", wantErr: true},
+	}
+	for _, tt := range tests {
+		if err := validateIPRange(tt.start, tt.end); (err != nil) != tt.wantErr {
+			t.Errorf("validateIPRange(%q, %q) error = %v, wantErr %v", tt.start, tt.end, err, tt.wantErr)
+		}
+	}
+}
+
+func Test_validateIPAccessControlAddress(t *testing.T) {
+	for _, v := range []string{"*", "192.168.1.1", "::1"} {
+		if _, errs := validateIPAccessControlAddress(v, "start_ip_address"); len(errs) != 0 {
+			t.Errorf("unexpected errors for %q: %v", v, errs)
+		}
+	}
+	for _, v := range []string{"192.168.1", "10.0.0.0/8", "localhost"} {
+		if _, errs := validateIPAccessControlAddress(v, "start_ip_address"); len(errs) == 0 {
+			t.Errorf("expected an error for %q", v)
+		}
+	}
+}
+
+func Test_validateIPAccessControlList(t *testing.T) {
+	// unknownValue is how the SDK marks a value not known until apply.
+	const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+	tests := []struct {
+		name    string
+		entries []interface{}
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			entries: []interface{}{
+				map[string]interface{}{"action": "Block", "start_ip_address": "*"},
+				map[string]interface{}{"action": "Allow", "start_ip_address": "10.0.0.1", "end_ip_address": "10.0.0.255"},
+			},
+		},
+		{
+			name: "missing wildcard",
+			entries: []interface{}{
+				map[string]interface{}{"action": "Allow", "start_ip_address": "10.0.0.1"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown address may be the wildcard",
+			entries: []interface{}{
+				map[string]interface{}{"action": "Block", "start_ip_address": unknownValue},
+				map[string]interface{}{"action": "Allow", "start_ip_address": "10.0.0.1"},
+			},
+		},
+		{
+			name: "invalid range after an unknown address",
+			entries: []interface{}{
+				map[string]interface{}{"action": "Block", "start_ip_address": unknownValue},
+				map[string]interface{}{"action": "Allow", "start_ip_address": "10.0.1.0", "end_ip_address": "10.0.0.255"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":  "office",
+				"entry": tt.entries,
+			})
+			if _, err := newIPAccessControlList().Diff(context.Background(), nil, config, Client{}); (err != nil) != tt.wantErr {
+				t.Errorf("Diff() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_ipAccessControlList_singleAddressHasNoDiff(t *testing.T) {
+	_, client := newFakeClient(t)
+	ctx := context.Background()
+
+	config := map[string]interface{}{
+		"name": "office",
+		"entry": []interface{}{
+			map[string]interface{}{"action": "Block", "start_ip_address": "*"},
+			map[string]interface{}{"action": "Allow", "start_ip_address": "10.0.0.1"},
+			map[string]interface{}{"action": "Allow", "start_ip_address": "10.0.1.0", "end_ip_address": "10.0.1.255"},
+		},
+	}
+
+	r := newIPAccessControlList()
+	data := r.TestResourceData()
+	_ = data.Set("name", config["name"])
+	_ = data.Set("entry", config["entry"])
+
+	if diags := createIPAccessControlList(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if diags := readIPAccessControlList(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+
+	if got := data.Get("entry.1.end_ip_address"); got != "" {
+		t.Errorf("entry.1.end_ip_address = %q, want it to be left unset", got)
+	}
+	if got := data.Get("entry.2.end_ip_address"); got != "10.0.1.255" {
+		t.Errorf("entry.2.end_ip_address = %q, want %q", got, "10.0.1.255")
+	}
+	if diff := planDiff(t, r, data, config, client); diff != nil {
+		t.Errorf("expected no changes after read, got %#v", diff.Attributes)
+	}
+}
@@ -0,0 +1,82 @@
+package fusionauth
+
+import (
+	"context"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func newKafkaMessenger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createKafkaMessenger,
+		ReadContext:   readKafkaMessenger,
+		UpdateContext: updateKafkaMessenger,
+		DeleteContext: deleteMessenger,
+		Schema: messengerSchema(map[string]*schema.Schema{
+			"default_topic": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Kafka topic to send messages to.",
+			},
+			"producer": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The Kafka producer configuration, i.e. bootstrap.servers. These are passed to the Kafka producer as is. The values may hold credentials, such as sasl.jaas.config, so they are not shown in plans.",
+			},
+		}),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func buildKafkaMessenger(data *schema.ResourceData) fusionauth.KafkaMessengerConfiguration {
+	messenger := fusionauth.KafkaMessengerConfiguration{
+		BaseMessengerConfiguration: buildBaseMessenger(data, fusionauth.MessengerType_Kafka),
+		DefaultTopic:               data.Get("default_topic").(string),
+	}
+
+	if i, ok := data.GetOk("producer"); ok {
+		messenger.Producer = intMapToStringMap(i.(map[string]interface{}))
+	}
+
+	return messenger
+}
+
+func createKafkaMessenger(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	messenger, diags := createMessenger(ctx, data, i.(Client), data.Get("id").(string), buildKafkaMessenger(data))
+	if diags != nil {
+		return diags
+	}
+
+	data.SetId(messenger.Id)
+	return nil
+}
+
+func readKafkaMessenger(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	messenger, diags := retrieveMessenger[fusionauth.KafkaMessengerConfiguration](ctx, data, i.(Client))
+	if messenger == nil {
+		return diags
+	}
+
+	if diags := buildResourceDataFromBaseMessenger(data, messenger.BaseMessengerConfiguration); diags != nil {
+		return diags
+	}
+	if err := data.Set("default_topic", messenger.DefaultTopic); err != nil {
+		return diag.Errorf("messenger.default_topic: %s", err.Error())
+	}
+	if err := data.Set("producer", messenger.Producer); err != nil {
+		return diag.Errorf("messenger.producer: %s", err.Error())
+	}
+
+	return nil
+}
+
+func updateKafkaMessenger(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	_, diags := updateMessenger(ctx, data, i.(Client), buildKafkaMessenger(data))
+	return diags
+}
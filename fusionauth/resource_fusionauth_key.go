@@ -12,11 +12,11 @@ import (
 func newKey() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: createKey,
-		ReadContext: func(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-			return keyRead(data, buildResourceDataFromKey, i)
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			return keyRead(ctx, data, buildResourceDataFromKey, i)
 		},
-		UpdateContext: func(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-			return keyUpdate(data, buildKey, i)
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			return keyUpdate(ctx, data, buildKey, i)
 		},
 		DeleteContext: keyDelete,
 		Schema: map[string]*schema.Schema{
@@ -76,7 +76,7 @@ func buildKey(data *schema.ResourceData) fusionauth.Key {
 	return l
 }
 
-func createKey(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createKey(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	l := buildKey(data)
 
@@ -85,14 +85,14 @@ func createKey(_ context.Context, data *schema.ResourceData, i interface{}) diag
 		keyID = a.(string)
 	}
 
-	resp, faErrs, err := client.FAClient.GenerateKey(keyID, fusionauth.KeyRequest{
+	resp, faErrs, err := client.FAClient.GenerateKeyWithContext(ctx, keyID, fusionauth.KeyRequest{
 		Key: l,
 	})
 	if err != nil {
 		return diag.Errorf("CreateKey err: %v", err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	data.SetId(resp.Key.Id)
@@ -2,6 +2,7 @@ package fusionauth
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
@@ -16,6 +17,7 @@ func newLambda() *schema.Resource {
 		ReadContext:   readLambda,
 		UpdateContext: updateLambda,
 		DeleteContext: deleteLambda,
+		CustomizeDiff: customizeDiffLambdaType,
 		Schema: map[string]*schema.Schema{
 			"lambda_id": {
 				Type:         schema.TypeString,
@@ -78,6 +80,10 @@ func newLambda() *schema.Resource {
 					string(fusionauth.LambdaType_XboxReconcile),
 					string(fusionauth.LambdaType_SelfServiceRegistrationValidation),
 					string(fusionauth.LambdaType_ClientCredentialsJWTPopulate),
+					string(fusionauth.LambdaType_SCIMServerGroupRequestConverter),
+					string(fusionauth.LambdaType_SCIMServerGroupResponseConverter),
+					string(fusionauth.LambdaType_SCIMServerUserRequestConverter),
+					string(fusionauth.LambdaType_SCIMServerUserResponseConverter),
 				}, false),
 				Description: "The lambda type.",
 			},
@@ -100,28 +106,69 @@ func buildLambda(data *schema.ResourceData) fusionauth.Lambda {
 	return l
 }
 
-func createLambda(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+// customizeDiffLambdaType fails the plan, if the provider is configured with
+// strict_version_check, for a lambda type lambdaTypeWarnings otherwise warns
+// about on apply.
+func customizeDiffLambdaType(_ context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	client, ok := i.(Client)
+	if !ok || !client.StrictVersionCheck {
+		return nil
+	}
+
+	return unsupportedVersionError(lambdaTypeWarnings(diff, client))
+}
+
+// lambdaTypeWarnings warns if the lambda type requires a newer FusionAuth
+// version than the one the provider is connected to.
+func lambdaTypeWarnings(data attributeGetter, client Client) diag.Diagnostics {
+	minVersions := map[string]string{
+		string(fusionauth.LambdaType_LinkedInReconcile):                 "1.23.0",
+		string(fusionauth.LambdaType_EpicGamesReconcile):                "1.28.0",
+		string(fusionauth.LambdaType_NintendoReconcile):                 "1.28.0",
+		string(fusionauth.LambdaType_SonyPSNReconcile):                  "1.28.0",
+		string(fusionauth.LambdaType_SteamReconcile):                    "1.28.0",
+		string(fusionauth.LambdaType_TwitchReconcile):                   "1.28.0",
+		string(fusionauth.LambdaType_XboxReconcile):                     "1.28.0",
+		string(fusionauth.LambdaType_SCIMServerGroupRequestConverter):   "1.36.0",
+		string(fusionauth.LambdaType_SCIMServerGroupResponseConverter):  "1.36.0",
+		string(fusionauth.LambdaType_SCIMServerUserRequestConverter):    "1.36.0",
+		string(fusionauth.LambdaType_SCIMServerUserResponseConverter):   "1.36.0",
+		string(fusionauth.LambdaType_SelfServiceRegistrationValidation): "1.43.0",
+	}
+
+	lambdaType := data.Get("type").(string)
+	if minVersion, ok := minVersions[lambdaType]; ok && !client.versionAtLeast(minVersion) {
+		warning := unsupportedVersionWarning(client, "type", minVersion)
+		warning.Summary = fmt.Sprintf("lambda type %s is not supported by FusionAuth %s", lambdaType, client.Version)
+		return diag.Diagnostics{warning}
+	}
+
+	return nil
+}
+
+func createLambda(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
+	warnings := lambdaTypeWarnings(data, client)
 	l := buildLambda(data)
-	resp, faErrs, err := client.FAClient.CreateLambda(l.Id, fusionauth.LambdaRequest{
+	resp, faErrs, err := client.FAClient.CreateLambdaWithContext(ctx, l.Id, fusionauth.LambdaRequest{
 		Lambda: l,
 	})
 	if err != nil {
 		return diag.Errorf("CreateLambda err: %v", err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return append(warnings, diags...)
 	}
 	data.SetId(resp.Lambda.Id)
-	return nil
+	return warnings
 }
 
-func readLambda(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readLambda(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.RetrieveLambda(id)
+	resp, faErrs, err := client.FAClient.RetrieveLambdaWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -130,8 +177,8 @@ func readLambda(_ context.Context, data *schema.ResourceData, i interface{}) dia
 		data.SetId("")
 		return nil
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	l := resp.Lambda
@@ -154,35 +201,36 @@ func readLambda(_ context.Context, data *schema.ResourceData, i interface{}) dia
 	return nil
 }
 
-func updateLambda(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateLambda(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
+	warnings := lambdaTypeWarnings(data, client)
 	l := buildLambda(data)
 
-	resp, faErrs, err := client.FAClient.UpdateLambda(data.Id(), fusionauth.LambdaRequest{
+	resp, faErrs, err := client.FAClient.UpdateLambdaWithContext(ctx, data.Id(), fusionauth.LambdaRequest{
 		Lambda: l,
 	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return append(warnings, diags...)
 	}
 
-	return nil
+	return warnings
 }
 
-func deleteLambda(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteLambda(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteLambda(id)
+	resp, faErrs, err := client.FAClient.DeleteLambdaWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
@@ -0,0 +1,301 @@
+package fusionauth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func newLDAPConnector() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createLDAPConnector,
+		ReadContext:   readLDAPConnector,
+		UpdateContext: updateLDAPConnector,
+		DeleteContext: deleteLDAPConnector,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The Id to use for the new Connector. If not specified a secure random UUID will be generated.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"authentication_url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The fully qualified LDAP URL to authenticate, for example ldaps://ldap.example.com:636.",
+			},
+			"base_structure": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The top of the LDAP directory hierarchy, for example dc=example,dc=com.",
+			},
+			"connect_timeout": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The connect timeout for the LDAP connection, in milliseconds. Value must be greater than 0.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "An object that can hold any information about the Connector that should be persisted.",
+			},
+			"debug": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if debug should be enabled to create an event log to assist in debugging integration errors.",
+			},
+			"identifying_attribute": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The entry attribute name which is the first component of the distinguished name of entries in the directory, for example uid or cn.",
+			},
+			"lambda_reconcile_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The Id of an existing Lambda of type LDAPConnectorReconcile. The lambda maps the LDAP attributes to a FusionAuth user.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"login_id_attribute": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The entity attribute name which stores the identifier that is used for logging the user in, for example mail or sAMAccountName.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique Connector name.",
+			},
+			"read_timeout": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The read timeout for the LDAP connection, in milliseconds. Value must be greater than 0.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"requested_attributes": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The list of attributes to request from the LDAP server. These are passed to the reconcile lambda.",
+			},
+			"security_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(fusionauth.LDAPSecurityMethod_None),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(fusionauth.LDAPSecurityMethod_None),
+					string(fusionauth.LDAPSecurityMethod_LDAPS),
+					string(fusionauth.LDAPSecurityMethod_StartTLS),
+				}, false),
+				Description: "The LDAP security method. Possible values are: None (requests are made in the clear), LDAPS (an SSL connection is used) and StartTLS (a StartTLS extended request is sent before authenticating).",
+			},
+			"system_account_dn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The distinguished name of an entry that has read access to the directory, for example cn=admin,dc=example,dc=com.",
+			},
+			"system_account_password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of an entry that has read access to the directory.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func buildLDAPConnector(data *schema.ResourceData) fusionauth.LDAPConnectorConfiguration {
+	connector := fusionauth.LDAPConnectorConfiguration{
+		AuthenticationURL: data.Get("authentication_url").(string),
+		BaseConnectorConfiguration: fusionauth.BaseConnectorConfiguration{
+			Id:    data.Get("id").(string),
+			Data:  data.Get("data").(map[string]interface{}),
+			Debug: data.Get("debug").(bool),
+			Name:  data.Get("name").(string),
+			Type:  fusionauth.ConnectorType_LDAP,
+		},
+		BaseStructure:        data.Get("base_structure").(string),
+		ConnectTimeout:       data.Get("connect_timeout").(int),
+		IdentifyingAttribute: data.Get("identifying_attribute").(string),
+		LambdaConfiguration: fusionauth.ConnectorLambdaConfiguration{
+			ReconcileId: data.Get("lambda_reconcile_id").(string),
+		},
+		LoginIdAttribute:      data.Get("login_id_attribute").(string),
+		ReadTimeout:           data.Get("read_timeout").(int),
+		SecurityMethod:        fusionauth.LDAPSecurityMethod(data.Get("security_method").(string)),
+		SystemAccountDN:       data.Get("system_account_dn").(string),
+		SystemAccountPassword: data.Get("system_account_password").(string),
+	}
+
+	for _, attr := range data.Get("requested_attributes").([]interface{}) {
+		connector.RequestedAttributes = append(connector.RequestedAttributes, attr.(string))
+	}
+
+	return connector
+}
+
+func createLDAPConnector(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	connector := buildLDAPConnector(data)
+	resp, faErrs, err := CreateLDAPConnector(ctx, client.FAClient, connector.Id, LDAPConnectorRequest{Connector: connector})
+	if err != nil {
+		return diag.Errorf("CreateLDAPConnector err: %v", err)
+	}
+
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+	data.SetId(resp.Connector.Id)
+	return nil
+}
+
+func readLDAPConnector(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	id := data.Id()
+
+	resp, faErrs, err := RetrieveLDAPConnector(ctx, client.FAClient, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		data.SetId("")
+		return nil
+	}
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	connector := resp.Connector
+	if err := data.Set("authentication_url", connector.AuthenticationURL); err != nil {
+		return diag.Errorf("connector.authentication_url: %s", err.Error())
+	}
+	if err := data.Set("base_structure", connector.BaseStructure); err != nil {
+		return diag.Errorf("connector.base_structure: %s", err.Error())
+	}
+	if err := data.Set("connect_timeout", connector.ConnectTimeout); err != nil {
+		return diag.Errorf("connector.connect_timeout: %s", err.Error())
+	}
+	if err := data.Set("data", connector.Data); err != nil {
+		return diag.Errorf("connector.data: %s", err.Error())
+	}
+	if err := data.Set("debug", connector.Debug); err != nil {
+		return diag.Errorf("connector.debug: %s", err.Error())
+	}
+	if err := data.Set("identifying_attribute", connector.IdentifyingAttribute); err != nil {
+		return diag.Errorf("connector.identifying_attribute: %s", err.Error())
+	}
+	if err := data.Set("lambda_reconcile_id", connector.LambdaConfiguration.ReconcileId); err != nil {
+		return diag.Errorf("connector.lambda_reconcile_id: %s", err.Error())
+	}
+	if err := data.Set("login_id_attribute", connector.LoginIdAttribute); err != nil {
+		return diag.Errorf("connector.login_id_attribute: %s", err.Error())
+	}
+	if err := data.Set("name", connector.Name); err != nil {
+		return diag.Errorf("connector.name: %s", err.Error())
+	}
+	if err := data.Set("read_timeout", connector.ReadTimeout); err != nil {
+		return diag.Errorf("connector.read_timeout: %s", err.Error())
+	}
+	if err := data.Set("requested_attributes", connector.RequestedAttributes); err != nil {
+		return diag.Errorf("connector.requested_attributes: %s", err.Error())
+	}
+	if err := data.Set("security_method", connector.SecurityMethod); err != nil {
+		return diag.Errorf("connector.security_method: %s", err.Error())
+	}
+	if err := data.Set("system_account_dn", connector.SystemAccountDN); err != nil {
+		return diag.Errorf("connector.system_account_dn: %s", err.Error())
+	}
+	// FusionAuth does not return the password, so only overwrite the
+	// configured value if one was returned.
+	if connector.SystemAccountPassword != "" {
+		if err := data.Set("system_account_password", connector.SystemAccountPassword); err != nil {
+			return diag.Errorf("connector.system_account_password: %s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+func updateLDAPConnector(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	connector := buildLDAPConnector(data)
+
+	resp, faErrs, err := UpdateLDAPConnector(ctx, client.FAClient, data.Id(), LDAPConnectorRequest{Connector: connector})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	return nil
+}
+
+func deleteLDAPConnector(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	id := data.Id()
+
+	resp, faErrs, err := client.FAClient.DeleteConnectorWithContext(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	return nil
+}
+
+type LDAPConnectorRequest struct {
+	Connector fusionauth.LDAPConnectorConfiguration `json:"connector,omitempty"`
+}
+
+type LDAPConnectorResponse struct {
+	fusionauth.BaseHTTPResponse
+	Connector fusionauth.LDAPConnectorConfiguration `json:"connector,omitempty"`
+}
+
+func (b *LDAPConnectorResponse) SetStatus(status int) {
+	b.StatusCode = status
+}
+
+func CreateLDAPConnector(ctx context.Context, client fusionauth.FusionAuthClient, connectorID string, request LDAPConnectorRequest) (*LDAPConnectorResponse, *fusionauth.Errors, error) {
+	return makeLDAPConnectorRequest(ctx, client, connectorID, request, http.MethodPost)
+}
+
+func RetrieveLDAPConnector(ctx context.Context, client fusionauth.FusionAuthClient, connectorID string) (*LDAPConnectorResponse, *fusionauth.Errors, error) {
+	return makeLDAPConnectorRequest(ctx, client, connectorID, LDAPConnectorRequest{}, http.MethodGet)
+}
+
+func UpdateLDAPConnector(ctx context.Context, client fusionauth.FusionAuthClient, connectorID string, request LDAPConnectorRequest) (*LDAPConnectorResponse, *fusionauth.Errors, error) {
+	return makeLDAPConnectorRequest(ctx, client, connectorID, request, http.MethodPut)
+}
+
+func makeLDAPConnectorRequest(ctx context.Context, client fusionauth.FusionAuthClient, connectorID string, request LDAPConnectorRequest, method string) (*LDAPConnectorResponse, *fusionauth.Errors, error) {
+	var resp LDAPConnectorResponse
+	var errors fusionauth.Errors
+
+	restClient := client.Start(&resp, &errors)
+	err := restClient.WithUri("/api/connector").
+		WithUriSegment(connectorID).
+		WithJSONBody(request).
+		WithMethod(method).
+		Do(ctx)
+	if restClient.ErrorRef == nil {
+		return &resp, nil, err
+	}
+	return &resp, &errors, err
+}
@@ -0,0 +1,170 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccLDAPConnector(t *testing.T) {
+	resourceName := randString10()
+	tfResourcePath := fmt.Sprintf("fusionauth_ldap_connector.test_%s", resourceName)
+
+	startAuthenticationURL, endAuthenticationURL := "ldap://ldap-start.example.com:389", "ldaps://ldap-end.example.com:636"
+	startSecurityMethod, endSecurityMethod := "None", "LDAPS"
+	startSystemAccountPassword, endSystemAccountPassword := "super-secret-start", "super-secret-end"
+	startName, endName := "my-test-ldap-connector", "my-new-test-ldap-connector"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckLDAPConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPConnectorBasicConfig(
+					resourceName,
+					startAuthenticationURL,
+					startSecurityMethod,
+					startSystemAccountPassword,
+					startName,
+				),
+				Check: testLDAPConnectorAccTestCheckFuncs(
+					tfResourcePath,
+					startAuthenticationURL,
+					startSecurityMethod,
+					startSystemAccountPassword,
+					startName,
+				),
+			},
+			{
+				Config: testAccLDAPConnectorBasicConfig(
+					resourceName,
+					endAuthenticationURL,
+					endSecurityMethod,
+					endSystemAccountPassword,
+					endName,
+				),
+				Check: testLDAPConnectorAccTestCheckFuncs(
+					tfResourcePath,
+					endAuthenticationURL,
+					endSecurityMethod,
+					endSystemAccountPassword,
+					endName,
+				),
+			},
+			{
+				ResourceName:            tfResourcePath,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"system_account_password"},
+			},
+		},
+	})
+}
+
+func testAccLDAPConnectorBasicConfig(resourceName, authenticationURL, securityMethod, systemAccountPassword, name string) string {
+	return fmt.Sprintf(`
+	# LDAP connector setup
+	resource "fusionauth_lambda" "test_%[1]s" {
+		name    = "ldap-reconcile-%[1]s"
+		type    = "LDAPConnectorReconcile"
+		enabled = true
+		body    = "function reconcile(user, userAttributes) {}"
+	}
+
+	resource "fusionauth_ldap_connector" "test_%[1]s" {
+		authentication_url      = "%[2]s"
+		base_structure          = "dc=example,dc=com"
+		connect_timeout         = 1000
+		data                    = { "important-key" : "important-value" }
+		debug                   = false
+		identifying_attribute   = "uid"
+		lambda_reconcile_id     = fusionauth_lambda.test_%[1]s.id
+		login_id_attribute      = "mail"
+		name                    = "%[5]s"
+		read_timeout            = 2000
+		requested_attributes    = ["uid", "mail", "givenName", "sn"]
+		security_method         = "%[3]s"
+		system_account_dn       = "cn=admin,dc=example,dc=com"
+		system_account_password = "%[4]s"
+	}
+	`, resourceName, authenticationURL, securityMethod, systemAccountPassword, name)
+}
+
+func testLDAPConnectorAccTestCheckFuncs(tfResourcePath, authenticationURL, securityMethod, systemAccountPassword, name string) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		testAccCheckLDAPConnectorExists(tfResourcePath),
+		resource.TestCheckResourceAttr(tfResourcePath, "authentication_url", authenticationURL),
+		resource.TestCheckResourceAttr(tfResourcePath, "base_structure", "dc=example,dc=com"),
+		resource.TestCheckResourceAttr(tfResourcePath, "connect_timeout", "1000"),
+		resource.TestCheckResourceAttr(tfResourcePath, "data.important-key", "important-value"),
+		resource.TestCheckResourceAttr(tfResourcePath, "debug", "false"),
+		resource.TestCheckResourceAttr(tfResourcePath, "identifying_attribute", "uid"),
+		resource.TestCheckResourceAttrSet(tfResourcePath, "lambda_reconcile_id"),
+		resource.TestCheckResourceAttr(tfResourcePath, "login_id_attribute", "mail"),
+		resource.TestCheckResourceAttr(tfResourcePath, "name", name),
+		resource.TestCheckResourceAttr(tfResourcePath, "read_timeout", "2000"),
+		resource.TestCheckResourceAttr(tfResourcePath, "requested_attributes.#", "4"),
+		resource.TestCheckResourceAttr(tfResourcePath, "requested_attributes.1", "mail"),
+		resource.TestCheckResourceAttr(tfResourcePath, "security_method", securityMethod),
+		resource.TestCheckResourceAttr(tfResourcePath, "system_account_dn", "cn=admin,dc=example,dc=com"),
+		resource.TestCheckResourceAttr(tfResourcePath, "system_account_password", systemAccountPassword),
+	)
+}
+
+func testAccCheckLDAPConnectorExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource id is set")
+		}
+
+		connector, faErrs, err := RetrieveLDAPConnector(context.Background(), fusionauthClient(), rs.Primary.ID)
+		if errs := checkFusionauthErrors(faErrs, err); errs != nil {
+			return err
+		}
+
+		if connector == nil || connector.StatusCode != http.StatusOK {
+			return fmt.Errorf("failed to get resource: %#+v", connector)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLDAPConnectorDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fusionauth_ldap_connector" {
+			continue
+		}
+
+		// Ensure we retry for eventual consistency in HA setups.
+		err := resource.RetryContext(context.Background(), retryTimeout, func() *resource.RetryError {
+			connector, faErrs, err := RetrieveLDAPConnector(context.Background(), fusionauthClient(), rs.Primary.ID)
+			if errs := checkFusionauthRetryErrors(faErrs, err); errs != nil {
+				return errs
+			}
+
+			if connector != nil && connector.StatusCode == http.StatusNotFound {
+				// resource destroyed!
+				return nil
+			}
+
+			return resource.RetryableError(fmt.Errorf("fusionauth resource still exists: %s", rs.Primary.ID))
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package fusionauth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func newMessageTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createMessageTemplate,
+		ReadContext:   readMessageTemplate,
+		UpdateContext: updateMessageTemplate,
+		DeleteContext: deleteMessageTemplate,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The Id to use for the new Message Template. If not specified a secure random UUID will be generated.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "An object that can hold any information about the Message Template that should be persisted.",
+			},
+			"default_template": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The default Message Template.",
+				DiffSuppressFunc: diffSuppressTemplate,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+			},
+			"localized_templates": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				Description:      "The Message Template used when sending messages to users who speak other languages. This overrides the default Message Template based on the user’s preferred languages.",
+				DiffSuppressFunc: diffSuppressTemplate,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  `A descriptive name for the Message Template (i.e. "Two Factor Code Message")`,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func buildMessageTemplate(data *schema.ResourceData) fusionauth.SMSMessageTemplate {
+	t := fusionauth.SMSMessageTemplate{
+		MessageTemplate: fusionauth.MessageTemplate{
+			Id:   data.Get("id").(string),
+			Data: data.Get("data").(map[string]interface{}),
+			Name: data.Get("name").(string),
+			Type: fusionauth.MessageType_SMS,
+		},
+		DefaultTemplate: data.Get("default_template").(string),
+	}
+
+	if i, ok := data.GetOk("localized_templates"); ok {
+		t.LocalizedTemplates = intMapToStringMap(i.(map[string]interface{}))
+	}
+
+	return t
+}
+
+func createMessageTemplate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	t := buildMessageTemplate(data)
+
+	resp, diags := makeTypedRequest(ctx, data, client, messageTemplateAPI, t.Id, &t, http.MethodPost)
+	if diags != nil {
+		return diags
+	}
+
+	data.SetId(resp.Id)
+	return nil
+}
+
+func readMessageTemplate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	t, diags := makeTypedRequest[fusionauth.SMSMessageTemplate](ctx, data, client, messageTemplateAPI, data.Id(), nil, http.MethodGet)
+	if diags != nil {
+		return diags
+	}
+	if t == nil {
+		data.SetId("")
+		return nil
+	}
+
+	return buildResourceDataFromMessageTemplate(data, *t)
+}
+
+func buildResourceDataFromMessageTemplate(data *schema.ResourceData, t fusionauth.SMSMessageTemplate) diag.Diagnostics {
+	if err := data.Set("data", t.Data); err != nil {
+		return diag.Errorf("message_template.data: %s", err.Error())
+	}
+	if err := data.Set("default_template", t.DefaultTemplate); err != nil {
+		return diag.Errorf("message_template.default_template: %s", err.Error())
+	}
+	if err := data.Set("localized_templates", t.LocalizedTemplates); err != nil {
+		return diag.Errorf("message_template.localized_templates: %s", err.Error())
+	}
+	if err := data.Set("name", t.Name); err != nil {
+		return diag.Errorf("message_template.name: %s", err.Error())
+	}
+
+	return nil
+}
+
+func updateMessageTemplate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	t := buildMessageTemplate(data)
+
+	_, diags := makeTypedRequest(ctx, data, client, messageTemplateAPI, data.Id(), &t, http.MethodPut)
+	return diags
+}
+
+func deleteMessageTemplate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	_, diags := makeTypedRequest[fusionauth.SMSMessageTemplate](ctx, data, client, messageTemplateAPI, data.Id(), nil, http.MethodDelete)
+	return diags
+}
@@ -0,0 +1,107 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMessageTemplate(t *testing.T) {
+	resourceName := randString10()
+	tfResourcePath := fmt.Sprintf("fusionauth_message_template.test_%s", resourceName)
+	tfDataSourcePath := fmt.Sprintf("data.fusionauth_message_template.test_%s", resourceName)
+
+	startTemplate, endTemplate := "Your code is ${code}", "Your one-time code is ${code}"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("fusionauth_message_template", "/api/message/template"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageTemplateConfig(resourceName, startTemplate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfResourcePath, "name", "Two Factor Code "+resourceName),
+					resource.TestCheckResourceAttr(tfResourcePath, "default_template", startTemplate),
+					resource.TestCheckResourceAttr(tfResourcePath, "localized_templates.de", "Ihr Code lautet ${code}"),
+					resource.TestCheckResourceAttrPair(tfDataSourcePath, "id", tfResourcePath, "id"),
+					resource.TestCheckResourceAttr(tfDataSourcePath, "default_template", startTemplate),
+				),
+			},
+			{
+				Config: testAccMessageTemplateConfig(resourceName, endTemplate),
+				Check:  resource.TestCheckResourceAttr(tfResourcePath, "default_template", endTemplate),
+			},
+			{
+				Config:             testAccMessageTemplateConfig(resourceName, endTemplate),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:      tfResourcePath,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMessageTemplateConfig(resourceName, defaultTemplate string) string {
+	return fmt.Sprintf(`
+	resource "fusionauth_message_template" "test_%[1]s" {
+		name             = "Two Factor Code %[1]s"
+		default_template = "%[2]s"
+		localized_templates = {
+			"de" = "Ihr Code lautet $${code}"
+		}
+	}
+
+	data "fusionauth_message_template" "test_%[1]s" {
+		name = fusionauth_message_template.test_%[1]s.name
+	}
+	`, resourceName, strings.ReplaceAll(defaultTemplate, "${", "$${"))
+}
+
+func Test_messageTemplate_roundTrip(t *testing.T) {
+	fake, client := newFakeClient(t)
+	ctx := context.Background()
+
+	data := newMessageTemplate().TestResourceData()
+	_ = data.Set("name", "Two Factor Code")
+	_ = data.Set("default_template", "Your code is ${code}")
+	_ = data.Set("localized_templates", map[string]interface{}{"de": "Ihr Code lautet ${code}"})
+
+	if diags := createMessageTemplate(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	stored := fake.collection("message-template")[data.Id()]
+	if stored["type"] != "SMS" || stored["defaultTemplate"] != "Your code is ${code}" {
+		t.Errorf("unexpected message template %#v", stored)
+	}
+
+	lookup := dataSourceMessageTemplate().TestResourceData()
+	_ = lookup.Set("name", "Two Factor Code")
+	if diags := dataSourceMessageTemplateRead(ctx, lookup, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if lookup.Id() != data.Id() {
+		t.Errorf("id = %q, want %q", lookup.Id(), data.Id())
+	}
+	if got := lookup.Get("localized_templates.de"); got != "Ihr Code lautet ${code}" {
+		t.Errorf("localized_templates.de = %q", got)
+	}
+
+	missing := dataSourceMessageTemplate().TestResourceData()
+	_ = missing.Set("name", "Unknown")
+	if diags := dataSourceMessageTemplateRead(ctx, missing, client); !diags.HasError() {
+		t.Error("expected an error for an unknown message template")
+	}
+	if diags := deleteMessageTemplate(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if _, ok := fake.collection("message-template")[data.Id()]; ok {
+		t.Error("expected the message template to be deleted")
+	}
+}
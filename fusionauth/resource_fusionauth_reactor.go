@@ -39,17 +39,17 @@ func buildReactor(data *schema.ResourceData) fusionauth.ReactorRequest {
 	return reactor
 }
 
-func createReactor(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createReactor(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	reactor := buildReactor(data)
 
-	resp, faErrs, err := client.FAClient.ActivateReactor(reactor)
+	resp, faErrs, err := client.FAClient.ActivateReactorWithContext(ctx, reactor)
 	if err != nil {
 		return diag.Errorf("CreateReactor err: %v", err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	// Every terraform resource needs an id. Since none of the fusion auth reactor apis return
@@ -60,11 +60,11 @@ func createReactor(_ context.Context, data *schema.ResourceData, i interface{})
 	return nil
 }
 
-func updateReactor(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateReactor(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	reactor := buildReactor(data)
 
-	resp, faErrs, err := client.FAClient.ActivateReactor(fusionauth.ReactorRequest{
+	resp, faErrs, err := client.FAClient.ActivateReactorWithContext(ctx, fusionauth.ReactorRequest{
 		LicenseId: reactor.LicenseId,
 		License:   reactor.License,
 	})
@@ -72,17 +72,17 @@ func updateReactor(_ context.Context, data *schema.ResourceData, i interface{})
 		return diag.Errorf("UpdateReactor err: %v", err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
 		data.Partial(true)
-		return diag.FromErr(err)
+		return diags
 	}
 	return nil
 }
 
-func deleteReactor(_ context.Context, _ *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteReactor(ctx context.Context, _ *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
-	resp, err := client.FAClient.DeactivateReactor()
+	resp, err := client.FAClient.DeactivateReactorWithContext(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -94,10 +94,10 @@ func deleteReactor(_ context.Context, _ *schema.ResourceData, i interface{}) dia
 	return nil
 }
 
-func readReactor(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readReactor(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
-	resp, err := client.FAClient.RetrieveReactorStatus()
+	resp, err := client.FAClient.RetrieveReactorStatusWithContext(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
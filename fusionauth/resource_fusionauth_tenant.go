@@ -12,6 +12,8 @@ func newTenant() *schema.Resource {
 		ReadContext:   readTenant,
 		UpdateContext: updateTenant,
 		DeleteContext: deleteTenant,
+		CustomizeDiff: customizeDiffMinVersions(tenantMinVersions),
+		Timeouts:      defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"source_tenant_id": {
 				Type:         schema.TypeString,
@@ -327,6 +329,13 @@ func newTenant() *schema.Resource {
 					},
 				},
 			},
+			"lambda_configuration": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem:     newTenantLambdaConfiguration(),
+			},
 			"login_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -736,6 +745,13 @@ func newTenant() *schema.Resource {
 				Computed: true,
 				Elem:     newTenantRegistrationConfiguration(),
 			},
+			"scim_server_configuration": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem:     newSCIMServerConfiguration(),
+			},
 			"theme_id": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -813,6 +829,13 @@ func newTenant() *schema.Resource {
 					},
 				},
 			},
+			"webauthn_configuration": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem:     newWebAuthnConfiguration(),
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -890,6 +913,162 @@ func newFamilyConfiguration() *schema.Resource {
 	}
 }
 
+func newTenantLambdaConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"scim_enterprise_user_request_converter_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "The Id of a SCIM User Request lambda that will be used to convert the SCIM Enterprise User request to a FusionAuth User.",
+			},
+			"scim_enterprise_user_response_converter_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "The Id of a SCIM User Response lambda that will be used to convert a FusionAuth Enterprise User to a SCIM Server response.",
+			},
+			"scim_group_request_converter_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "The Id of a SCIM Group Request lambda that will be used to convert the SCIM Group request to a FusionAuth Group.",
+			},
+			"scim_group_response_converter_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "The Id of a SCIM Group Response lambda that will be used to convert a FusionAuth Group to a SCIM Server response.",
+			},
+			"scim_user_request_converter_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "The Id of a SCIM User Request lambda that will be used to convert the SCIM User request to a FusionAuth User.",
+			},
+			"scim_user_response_converter_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "The Id of a SCIM User Response lambda that will be used to convert a FusionAuth User to a SCIM Server response.",
+			},
+		},
+	}
+}
+
+func newSCIMServerConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"client_entity_type_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "The Entity Type that will be used to represent SCIM Clients for this tenant.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether or not this tenant has the SCIM endpoints enabled.",
+			},
+			"schemas": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "SCIM User and Group schema definitions, keyed by schema URN. When omitted, FusionAuth uses the default schemas. Must be a JSON string.",
+				DiffSuppressFunc: diffSuppressJSON,
+				ValidateFunc:     validation.StringIsJSON,
+			},
+			"server_entity_type_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "The Entity Type that will be used to represent SCIM Servers for this tenant.",
+			},
+		},
+	}
+}
+
+func newWebAuthnConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bootstrap_workflow": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Elem:        newWebAuthnWorkflowConfiguration("any"),
+				Description: "The bootstrap workflow is used when the user must identify themselves prior to the WebAuthn ceremony, and can be used to authenticate from a new device.",
+			},
+			"debug": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if debug is enabled to create an event log to assist in debugging integration errors.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether or not this tenant has the WebAuthn feature enabled.",
+			},
+			"reauthentication_workflow": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Elem:        newWebAuthnWorkflowConfiguration("platform"),
+				Description: "The reauthentication workflow will automatically prompt a user to authenticate using WebAuthn for repeated logins from the same device.",
+			},
+			"relying_party_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The value this tenant will use for the Relying Party Id in WebAuthn ceremonies. Passkeys can only be used to authenticate on sites using the same Relying Party Id they were registered with. When omitted, FusionAuth will use the browser origin's effective domain.",
+			},
+			"relying_party_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The value this tenant will use for the Relying Party name in WebAuthn ceremonies. This value may be displayed by browser or OS dialogs. When omitted, FusionAuth will use the tenant issuer.",
+			},
+		},
+	}
+}
+
+func newWebAuthnWorkflowConfiguration(defaultAttachment string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"authenticator_attachment_preference": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  defaultAttachment,
+				ValidateFunc: validation.StringInSlice([]string{
+					"any",
+					"crossPlatform",
+					"platform",
+				}, false),
+				Description: "Determines the authenticator attachment requirement for WebAuthn passkey registration when using this workflow.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether or not this workflow is enabled.",
+			},
+			"user_verification_requirement": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "required",
+				ValidateFunc: validation.StringInSlice([]string{
+					"discouraged",
+					"preferred",
+					"required",
+				}, false),
+				Description: "Determines the user verification requirement for WebAuthn passkey registration and authentication when using this workflow.",
+			},
+		},
+	}
+}
+
 func newTenantRegistrationConfiguration() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
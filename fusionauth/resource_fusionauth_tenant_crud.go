@@ -9,8 +9,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func createTenant(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+// tenantMinVersions returns the attributes that require a newer FusionAuth
+// version than the oldest one supported by the provider.
+func tenantMinVersions() map[string]string {
+	return map[string]string{
+		"lambda_configuration.0.scim_enterprise_user_request_converter_id":  "1.36.0",
+		"lambda_configuration.0.scim_enterprise_user_response_converter_id": "1.36.0",
+		"lambda_configuration.0.scim_group_request_converter_id":            "1.36.0",
+		"lambda_configuration.0.scim_group_response_converter_id":           "1.36.0",
+		"lambda_configuration.0.scim_user_request_converter_id":             "1.36.0",
+		"lambda_configuration.0.scim_user_response_converter_id":            "1.36.0",
+		"scim_server_configuration.0.enabled":                               "1.36.0",
+		"webauthn_configuration.0.enabled":                                  "1.41.0",
+	}
+}
+
+func createTenant(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client).withContextDeadline()
+	warnings := unsupportedAttributeWarnings(data, client, tenantMinVersions())
 	tenant, diags := buildTenant(data)
 	if diags != nil {
 		return diags
@@ -26,24 +42,24 @@ func createTenant(_ context.Context, data *schema.ResourceData, i interface{}) d
 	if t, ok := data.GetOk("tenant_id"); ok {
 		tid = t.(string)
 	}
-	resp, faErrs, err := client.FAClient.CreateTenant(tid, t)
+	resp, faErrs, err := client.FAClient.CreateTenantWithContext(ctx, tid, t)
 	if err != nil {
 		return diag.Errorf("CreateTenant err: %v", err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return append(warnings, diags...)
 	}
 
 	data.SetId(resp.Tenant.Id)
-	return buildResourceDataFromTenant(resp.Tenant, data)
+	return append(warnings, buildResourceDataFromTenant(resp.Tenant, data)...)
 }
 
-func readTenant(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readTenant(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.RetrieveTenant(id)
+	resp, faErrs, err := client.FAClient.RetrieveTenantWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -52,15 +68,16 @@ func readTenant(_ context.Context, data *schema.ResourceData, i interface{}) dia
 		data.SetId("")
 		return nil
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return buildResourceDataFromTenant(resp.Tenant, data)
 }
 
-func updateTenant(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func updateTenant(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client).withContextDeadline()
+	warnings := unsupportedAttributeWarnings(data, client, tenantMinVersions())
 	tenant, diags := buildTenant(data)
 	if diags != nil {
 		return diags
@@ -71,26 +88,26 @@ func updateTenant(_ context.Context, data *schema.ResourceData, i interface{}) d
 		SourceTenantId: data.Get("source_tenant_id").(string),
 	}
 
-	resp, faErrs, err := client.FAClient.UpdateTenant(data.Id(), t)
+	resp, faErrs, err := client.FAClient.UpdateTenantWithContext(ctx, data.Id(), t)
 	if err != nil {
 		return diag.Errorf("UpdateTenant err: %v", err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return append(warnings, diags...)
 	}
 
-	return buildResourceDataFromTenant(resp.Tenant, data)
+	return append(warnings, buildResourceDataFromTenant(resp.Tenant, data)...)
 }
 
-func deleteTenant(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
-	resp, faErrs, err := client.FAClient.DeleteTenant(data.Id())
+func deleteTenant(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client).withContextDeadline()
+	resp, faErrs, err := client.FAClient.DeleteTenantWithContext(ctx, data.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
@@ -185,6 +185,14 @@ func buildTenant(data *schema.ResourceData) (fusionauth.Tenant, diag.Diagnostics
 				MaximumTimeToLiveInMinutes: data.Get("jwt_configuration.0.refresh_token_sliding_window_maximum_time_to_live_in_minutes").(int),
 			},
 		},
+		LambdaConfiguration: fusionauth.TenantLambdaConfiguration{
+			ScimEnterpriseUserRequestConverterId:  data.Get("lambda_configuration.0.scim_enterprise_user_request_converter_id").(string),
+			ScimEnterpriseUserResponseConverterId: data.Get("lambda_configuration.0.scim_enterprise_user_response_converter_id").(string),
+			ScimGroupRequestConverterId:           data.Get("lambda_configuration.0.scim_group_request_converter_id").(string),
+			ScimGroupResponseConverterId:          data.Get("lambda_configuration.0.scim_group_response_converter_id").(string),
+			ScimUserRequestConverterId:            data.Get("lambda_configuration.0.scim_user_request_converter_id").(string),
+			ScimUserResponseConverterId:           data.Get("lambda_configuration.0.scim_user_response_converter_id").(string),
+		},
 		LoginConfiguration: fusionauth.TenantLoginConfiguration{
 			RequireAuthentication: data.Get("login_configuration.0.require_authentication").(bool),
 		},
@@ -289,6 +297,11 @@ func buildTenant(data *schema.ResourceData) (fusionauth.Tenant, diag.Diagnostics
 			SiteKey:       data.Get("captcha_configuration.0.site_key").(string),
 			Threshold:     data.Get("captcha_configuration.0.threshold").(float64),
 		},
+		ScimServerConfiguration: fusionauth.TenantSCIMServerConfiguration{
+			Enableable:         buildEnableable("scim_server_configuration.0.enabled", data),
+			ClientEntityTypeId: data.Get("scim_server_configuration.0.client_entity_type_id").(string),
+			ServerEntityTypeId: data.Get("scim_server_configuration.0.server_entity_type_id").(string),
+		},
 		ThemeId: data.Get("theme_id").(string),
 		UserDeletePolicy: fusionauth.TenantUserDeletePolicy{
 			Unverified: fusionauth.TimeBasedDeletePolicy{
@@ -304,6 +317,22 @@ func buildTenant(data *schema.ResourceData) (fusionauth.Tenant, diag.Diagnostics
 				Strategy:       fusionauth.UniqueUsernameStrategy(data.Get("username_configuration.0.unique.0.strategy").(string)),
 			},
 		},
+		WebAuthnConfiguration: fusionauth.TenantWebAuthnConfiguration{
+			Enableable: buildEnableable("webauthn_configuration.0.enabled", data),
+			BootstrapWorkflow: fusionauth.TenantWebAuthnWorkflowConfiguration{
+				Enableable:                        buildEnableable("webauthn_configuration.0.bootstrap_workflow.0.enabled", data),
+				AuthenticatorAttachmentPreference: fusionauth.AuthenticatorAttachmentPreference(data.Get("webauthn_configuration.0.bootstrap_workflow.0.authenticator_attachment_preference").(string)),
+				UserVerificationRequirement:       fusionauth.UserVerificationRequirement(data.Get("webauthn_configuration.0.bootstrap_workflow.0.user_verification_requirement").(string)),
+			},
+			Debug: data.Get("webauthn_configuration.0.debug").(bool),
+			ReauthenticationWorkflow: fusionauth.TenantWebAuthnWorkflowConfiguration{
+				Enableable:                        buildEnableable("webauthn_configuration.0.reauthentication_workflow.0.enabled", data),
+				AuthenticatorAttachmentPreference: fusionauth.AuthenticatorAttachmentPreference(data.Get("webauthn_configuration.0.reauthentication_workflow.0.authenticator_attachment_preference").(string)),
+				UserVerificationRequirement:       fusionauth.UserVerificationRequirement(data.Get("webauthn_configuration.0.reauthentication_workflow.0.user_verification_requirement").(string)),
+			},
+			RelyingPartyId:   data.Get("webauthn_configuration.0.relying_party_id").(string),
+			RelyingPartyName: data.Get("webauthn_configuration.0.relying_party_name").(string),
+		},
 	}
 
 	connectorPolicies, connectorDiags := buildConnectorPolicies(data)
@@ -316,7 +345,13 @@ func buildTenant(data *schema.ResourceData) (fusionauth.Tenant, diag.Diagnostics
 		tenant.EmailConfiguration.AdditionalHeaders = additionalheaders
 	}
 
-	return tenant, append(connectorDiags, emailDiags...)
+	scimSchemas, scimDiags := jsonStringToMapStringInterface(data.Get("scim_server_configuration.0.schemas").(string))
+	if scimDiags == nil && len(scimSchemas) > 0 {
+		tenant.ScimServerConfiguration.Schemas = scimSchemas
+	}
+
+	diags := append(connectorDiags, emailDiags...)
+	return tenant, append(diags, scimDiags...)
 }
 
 func buildAdditionalHeaders(data *schema.ResourceData) (emailHeaders []fusionauth.EmailHeader, diags diag.Diagnostics) {
@@ -453,13 +488,13 @@ func buildResourceDataFromTenant(t fusionauth.Tenant, data *schema.ResourceData)
 			"set_password_email_template_id":              t.EmailConfiguration.SetPasswordEmailTemplateId,
 			"two_factor_method_add_email_template_id":     t.EmailConfiguration.TwoFactorMethodAddEmailTemplateId,
 			"two_factor_method_remove_email_template_id":  t.EmailConfiguration.TwoFactorMethodRemoveEmailTemplateId,
-			"username":                                    t.EmailConfiguration.Username,
-			"verification_email_template_id":              t.EmailConfiguration.VerificationEmailTemplateId,
-			"verification_strategy":                       t.EmailConfiguration.VerificationStrategy,
-			"verify_email":                                t.EmailConfiguration.VerifyEmail,
-			"verify_email_when_changed":                   t.EmailConfiguration.VerifyEmailWhenChanged,
-			"default_from_email":                          t.EmailConfiguration.DefaultFromEmail,
-			"default_from_name":                           t.EmailConfiguration.DefaultFromName,
+			"username":                       t.EmailConfiguration.Username,
+			"verification_email_template_id": t.EmailConfiguration.VerificationEmailTemplateId,
+			"verification_strategy":          t.EmailConfiguration.VerificationStrategy,
+			"verify_email":                   t.EmailConfiguration.VerifyEmail,
+			"verify_email_when_changed":      t.EmailConfiguration.VerifyEmailWhenChanged,
+			"default_from_email":             t.EmailConfiguration.DefaultFromEmail,
+			"default_from_name":              t.EmailConfiguration.DefaultFromName,
 			"unverified": []map[string]interface{}{{
 				"allow_email_change_when_gated": t.EmailConfiguration.Unverified.AllowEmailChangeWhenGated,
 				"behavior":                      t.EmailConfiguration.Unverified.Behavior,
@@ -596,6 +631,20 @@ func buildResourceDataFromTenant(t fusionauth.Tenant, data *schema.ResourceData)
 		return diag.Errorf("tenant.jwt_configuration: %s", err.Error())
 	}
 
+	err = data.Set("lambda_configuration", []map[string]interface{}{
+		{
+			"scim_enterprise_user_request_converter_id":  t.LambdaConfiguration.ScimEnterpriseUserRequestConverterId,
+			"scim_enterprise_user_response_converter_id": t.LambdaConfiguration.ScimEnterpriseUserResponseConverterId,
+			"scim_group_request_converter_id":            t.LambdaConfiguration.ScimGroupRequestConverterId,
+			"scim_group_response_converter_id":           t.LambdaConfiguration.ScimGroupResponseConverterId,
+			"scim_user_request_converter_id":             t.LambdaConfiguration.ScimUserRequestConverterId,
+			"scim_user_response_converter_id":            t.LambdaConfiguration.ScimUserResponseConverterId,
+		},
+	})
+	if err != nil {
+		return diag.Errorf("tenant.lambda_configuration: %s", err.Error())
+	}
+
 	err = data.Set("login_configuration", []map[string]interface{}{
 		{
 			"require_authentication": t.LoginConfiguration.RequireAuthentication,
@@ -768,6 +817,22 @@ func buildAdditionalResourceDataFromTenant(t fusionauth.Tenant, data *schema.Res
 		return diag.Errorf("tenant.registration_configuration: %s", err.Error())
 	}
 
+	scimSchemas, diags := mapStringInterfaceToJSONString(t.ScimServerConfiguration.Schemas)
+	if diags != nil {
+		return diags
+	}
+	err = data.Set("scim_server_configuration", []map[string]interface{}{
+		{
+			"client_entity_type_id": t.ScimServerConfiguration.ClientEntityTypeId,
+			"enabled":               t.ScimServerConfiguration.Enabled,
+			"schemas":               scimSchemas,
+			"server_entity_type_id": t.ScimServerConfiguration.ServerEntityTypeId,
+		},
+	})
+	if err != nil {
+		return diag.Errorf("tenant.scim_server_configuration: %s", err.Error())
+	}
+
 	if err := data.Set("theme_id", t.ThemeId); err != nil {
 		return diag.Errorf("tenant.theme_id: %s", err.Error())
 	}
@@ -796,6 +861,28 @@ func buildAdditionalResourceDataFromTenant(t fusionauth.Tenant, data *schema.Res
 		return diag.Errorf("tenant.username_configuration: %s", err.Error())
 	}
 
+	err = data.Set("webauthn_configuration", []map[string]interface{}{
+		{
+			"bootstrap_workflow": []map[string]interface{}{{
+				"authenticator_attachment_preference": t.WebAuthnConfiguration.BootstrapWorkflow.AuthenticatorAttachmentPreference,
+				"enabled":                             t.WebAuthnConfiguration.BootstrapWorkflow.Enabled,
+				"user_verification_requirement":       t.WebAuthnConfiguration.BootstrapWorkflow.UserVerificationRequirement,
+			}},
+			"debug":   t.WebAuthnConfiguration.Debug,
+			"enabled": t.WebAuthnConfiguration.Enabled,
+			"reauthentication_workflow": []map[string]interface{}{{
+				"authenticator_attachment_preference": t.WebAuthnConfiguration.ReauthenticationWorkflow.AuthenticatorAttachmentPreference,
+				"enabled":                             t.WebAuthnConfiguration.ReauthenticationWorkflow.Enabled,
+				"user_verification_requirement":       t.WebAuthnConfiguration.ReauthenticationWorkflow.UserVerificationRequirement,
+			}},
+			"relying_party_id":   t.WebAuthnConfiguration.RelyingPartyId,
+			"relying_party_name": t.WebAuthnConfiguration.RelyingPartyName,
+		},
+	})
+	if err != nil {
+		return diag.Errorf("tenant.webauthn_configuration: %s", err.Error())
+	}
+
 	e := make([]map[string]interface{}, 0, len(t.EventConfiguration.Events))
 	for k, v := range t.EventConfiguration.Events {
 		e = append(e, map[string]interface{}{
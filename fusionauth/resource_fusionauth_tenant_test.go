@@ -73,6 +73,21 @@ func TestAccFusionauthTenant_basic(t *testing.T) {
 					true,
 				),
 			},
+			{
+				// Test that the applied tenant does not drift
+				Config: testAccTenantResourceBasicConfig(
+					resourceName,
+					themeKey,
+					accessTokenKey,
+					idTokenKey,
+					endFromEmail,
+					endMinimumPasswordAgeSeconds,
+					endMinimumPasswordAgeEnabled,
+					true,
+				),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
 			{
 				// Test importing resource into state
 				ResourceName:            tfResourcePath,
@@ -281,6 +296,9 @@ func testTenantAccTestCheckFuncs(
 
 		resource.TestCheckResourceAttrSet(tfResourcePath, "theme_id"),
 
+		// scim_server_configuration
+		resource.TestCheckResourceAttr(tfResourcePath, "scim_server_configuration.0.enabled", "false"),
+
 		// user_delete_policy
 		resource.TestCheckResourceAttr(tfResourcePath, "user_delete_policy.0.unverified_enabled", "true"),
 		resource.TestCheckResourceAttr(tfResourcePath, "user_delete_policy.0.unverified_number_of_days_to_retain", "30"),
@@ -290,6 +308,18 @@ func testTenantAccTestCheckFuncs(
 		resource.TestCheckResourceAttr(tfResourcePath, "username_configuration.0.unique.0.number_of_digits", "8"),
 		resource.TestCheckResourceAttr(tfResourcePath, "username_configuration.0.unique.0.separator", "_"),
 		resource.TestCheckResourceAttr(tfResourcePath, "username_configuration.0.unique.0.strategy", "Always"),
+
+		// webauthn_configuration
+		resource.TestCheckResourceAttr(tfResourcePath, "webauthn_configuration.0.bootstrap_workflow.0.authenticator_attachment_preference", "crossPlatform"),
+		resource.TestCheckResourceAttr(tfResourcePath, "webauthn_configuration.0.bootstrap_workflow.0.enabled", "true"),
+		resource.TestCheckResourceAttr(tfResourcePath, "webauthn_configuration.0.bootstrap_workflow.0.user_verification_requirement", "preferred"),
+		resource.TestCheckResourceAttr(tfResourcePath, "webauthn_configuration.0.debug", "true"),
+		resource.TestCheckResourceAttr(tfResourcePath, "webauthn_configuration.0.enabled", "false"),
+		resource.TestCheckResourceAttr(tfResourcePath, "webauthn_configuration.0.reauthentication_workflow.0.authenticator_attachment_preference", "platform"),
+		resource.TestCheckResourceAttr(tfResourcePath, "webauthn_configuration.0.reauthentication_workflow.0.enabled", "true"),
+		resource.TestCheckResourceAttr(tfResourcePath, "webauthn_configuration.0.reauthentication_workflow.0.user_verification_requirement", "required"),
+		resource.TestCheckResourceAttr(tfResourcePath, "webauthn_configuration.0.relying_party_id", "example.com"),
+		resource.TestCheckResourceAttr(tfResourcePath, "webauthn_configuration.0.relying_party_name", "Example"),
 	)
 }
 
@@ -704,6 +734,23 @@ resource "fusionauth_tenant" "test_%[1]s" {
       strategy         = "Always"
     }
   }
+  webauthn_configuration {
+    bootstrap_workflow {
+      authenticator_attachment_preference = "crossPlatform"
+      enabled                             = true
+      user_verification_requirement       = "preferred"
+    }
+    debug = true
+    # requires paid edition of FusionAuth
+    enabled = false
+    reauthentication_workflow {
+      authenticator_attachment_preference = "platform"
+      enabled                             = true
+      user_verification_requirement       = "required"
+    }
+    relying_party_id   = "example.com"
+    relying_party_name = "Example"
+  }
 }
 `,
 		resourceName,
@@ -714,3 +761,54 @@ resource "fusionauth_tenant" "test_%[1]s" {
 		connectorPolicies,
 	)
 }
+
+func Test_tenant_scimServerConfiguration(t *testing.T) {
+	fake, client := newFakeClient(t)
+	ctx := context.Background()
+
+	data := newTenant().TestResourceData()
+	_ = data.Set("name", "SCIM")
+	_ = data.Set("lambda_configuration", []interface{}{map[string]interface{}{
+		"scim_user_request_converter_id":  "5b4f3a1e-3f6a-4a4e-9a57-2b7e8e2a6f01",
+		"scim_user_response_converter_id": "5b4f3a1e-3f6a-4a4e-9a57-2b7e8e2a6f02",
+	}})
+	_ = data.Set("scim_server_configuration", []interface{}{map[string]interface{}{
+		"client_entity_type_id": "5b4f3a1e-3f6a-4a4e-9a57-2b7e8e2a6f03",
+		"enabled":               true,
+		"schemas":               `{"urn:ietf:params:scim:schemas:core:2.0:User":{"name":"User"}}`,
+		"server_entity_type_id": "5b4f3a1e-3f6a-4a4e-9a57-2b7e8e2a6f04",
+	}})
+
+	if diags := createTenant(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+
+	stored := fake.collection("tenant")[data.Id()]
+	scim, _ := stored["scimServerConfiguration"].(map[string]interface{})
+	if scim["enabled"] != true || scim["clientEntityTypeId"] != "5b4f3a1e-3f6a-4a4e-9a57-2b7e8e2a6f03" {
+		t.Errorf("unexpected scimServerConfiguration %#v", scim)
+	}
+	schemas, _ := scim["schemas"].(map[string]interface{})
+	if _, ok := schemas["urn:ietf:params:scim:schemas:core:2.0:User"]; !ok {
+		t.Errorf("schemas not sent: %#v", scim["schemas"])
+	}
+	lambdas, _ := stored["lambdaConfiguration"].(map[string]interface{})
+	if lambdas["scimUserRequestConverterId"] != "5b4f3a1e-3f6a-4a4e-9a57-2b7e8e2a6f01" {
+		t.Errorf("unexpected lambdaConfiguration %#v", lambdas)
+	}
+
+	read := newTenant().TestResourceData()
+	read.SetId(data.Id())
+	if diags := readTenant(ctx, read, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if !diffSuppressJSON("", read.Get("scim_server_configuration.0.schemas").(string), data.Get("scim_server_configuration.0.schemas").(string), nil) {
+		t.Errorf("schemas = %s", read.Get("scim_server_configuration.0.schemas"))
+	}
+	if got := read.Get("lambda_configuration.0.scim_user_response_converter_id"); got != "5b4f3a1e-3f6a-4a4e-9a57-2b7e8e2a6f02" {
+		t.Errorf("lambda_configuration.0.scim_user_response_converter_id = %q", got)
+	}
+	if diags := unsupportedAttributeWarnings(data, Client{Version: "1.35.0"}, tenantMinVersions()); len(diags) != 3 {
+		t.Errorf("expected a warning for each SCIM attribute on a pre-SCIM server, got %#v", diags)
+	}
+}
@@ -16,6 +16,8 @@ func newTheme() *schema.Resource {
 		ReadContext:   readTheme,
 		UpdateContext: updateTheme,
 		DeleteContext: deleteTheme,
+		CustomizeDiff: customizeDiffMinVersions(themeMinVersions),
+		Timeouts:      defaultResourceTimeouts(),
 		// Ordered based on the documented schema at: https://fusionauth.io/docs/v1/tech/apis/themes/#create-a-theme
 		Schema: map[string]*schema.Schema{
 			"source_theme_id": {
@@ -452,8 +454,22 @@ func buildTheme(data *schema.ResourceData) fusionauth.Theme {
 	return t
 }
 
-func createTheme(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+// themeMinVersions returns the templates that require a newer FusionAuth
+// version than the oldest one supported by the provider.
+func themeMinVersions() map[string]string {
+	return map[string]string{
+		"account_webauthn_add":          "1.41.0",
+		"account_webauthn_delete":       "1.41.0",
+		"account_webauthn_index":        "1.41.0",
+		"oauth2_webauthn":               "1.41.0",
+		"oauth2_webauthn_reauth":        "1.41.0",
+		"oauth2_webauthn_reauth_enable": "1.41.0",
+	}
+}
+
+func createTheme(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client).withContextDeadline()
+	warnings := unsupportedAttributeWarnings(data, client, themeMinVersions())
 
 	req := fusionauth.ThemeRequest{
 		Theme: buildTheme(data),
@@ -463,25 +479,25 @@ func createTheme(_ context.Context, data *schema.ResourceData, i interface{}) di
 		req.SourceThemeId = srcTheme.(string)
 	}
 
-	resp, faErrs, err := client.FAClient.CreateTheme("", req)
+	resp, faErrs, err := client.FAClient.CreateThemeWithContext(ctx, "", req)
 
 	if err != nil {
 		return diag.Errorf("CreateTheme err: %v", err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return append(warnings, diags...)
 	}
 
 	data.SetId(resp.Theme.Id)
-	return buildResourceDataFromTheme(resp.Theme, data)
+	return append(warnings, buildResourceDataFromTheme(resp.Theme, data)...)
 }
 
-func readTheme(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readTheme(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.RetrieveTheme(id)
+	resp, faErrs, err := client.FAClient.RetrieveThemeWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -490,8 +506,8 @@ func readTheme(_ context.Context, data *schema.ResourceData, i interface{}) diag
 		data.SetId("")
 		return nil
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	t := resp.Theme
@@ -499,8 +515,9 @@ func readTheme(_ context.Context, data *schema.ResourceData, i interface{}) diag
 	return buildResourceDataFromTheme(t, data)
 }
 
-func updateTheme(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func updateTheme(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client).withContextDeadline()
+	warnings := unsupportedAttributeWarnings(data, client, themeMinVersions())
 	req := fusionauth.ThemeRequest{
 		Theme: buildTheme(data),
 	}
@@ -509,31 +526,31 @@ func updateTheme(_ context.Context, data *schema.ResourceData, i interface{}) di
 		req.SourceThemeId = srcTheme.(string)
 	}
 
-	resp, faErrs, err := client.FAClient.UpdateTheme(data.Id(), req)
+	resp, faErrs, err := client.FAClient.UpdateThemeWithContext(ctx, data.Id(), req)
 	if err != nil {
 		return diag.Errorf("UpdateTheme err: %v", err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return append(warnings, diags...)
 	}
 
 	data.SetId(resp.Theme.Id)
 
-	return buildResourceDataFromTheme(resp.Theme, data)
+	return append(warnings, buildResourceDataFromTheme(resp.Theme, data)...)
 }
 
-func deleteTheme(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func deleteTheme(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client).withContextDeadline()
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteTheme(id)
+	resp, faErrs, err := client.FAClient.DeleteThemeWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 	return nil
 }
@@ -0,0 +1,112 @@
+package fusionauth
+
+import (
+	"context"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func newTwilioMessenger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createTwilioMessenger,
+		ReadContext:   readTwilioMessenger,
+		UpdateContext: updateTwilioMessenger,
+		DeleteContext: deleteMessenger,
+		Schema: messengerSchema(map[string]*schema.Schema{
+			"account_sid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Twilio Account ID to use when connecting to the Twilio API.",
+			},
+			"auth_token": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The Twilio Auth Token to use when connecting to the Twilio API.",
+			},
+			"from_phone_number": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"from_phone_number", "messaging_service_sid"},
+				Description:  "The configured Twilio phone number that will be used to send messages. Required if messaging_service_sid is not set.",
+			},
+			"messaging_service_sid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"from_phone_number", "messaging_service_sid"},
+				Description:  "The Twilio message service Id, used when using Twilio Copilot to load balance between numbers. Required if from_phone_number is not set.",
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "https://api.twilio.com",
+				Description:  "The fully qualified URL of the Twilio API.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+		}),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func buildTwilioMessenger(data *schema.ResourceData) fusionauth.TwilioMessengerConfiguration {
+	return fusionauth.TwilioMessengerConfiguration{
+		BaseMessengerConfiguration: buildBaseMessenger(data, fusionauth.MessengerType_Twilio),
+		AccountSID:                 data.Get("account_sid").(string),
+		AuthToken:                  data.Get("auth_token").(string),
+		FromPhoneNumber:            data.Get("from_phone_number").(string),
+		MessagingServiceSid:        data.Get("messaging_service_sid").(string),
+		Url:                        data.Get("url").(string),
+	}
+}
+
+func createTwilioMessenger(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	messenger, diags := createMessenger(ctx, data, i.(Client), data.Get("id").(string), buildTwilioMessenger(data))
+	if diags != nil {
+		return diags
+	}
+
+	data.SetId(messenger.Id)
+	return nil
+}
+
+func readTwilioMessenger(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	messenger, diags := retrieveMessenger[fusionauth.TwilioMessengerConfiguration](ctx, data, i.(Client))
+	if messenger == nil {
+		return diags
+	}
+
+	if diags := buildResourceDataFromBaseMessenger(data, messenger.BaseMessengerConfiguration); diags != nil {
+		return diags
+	}
+	if err := data.Set("account_sid", messenger.AccountSID); err != nil {
+		return diag.Errorf("messenger.account_sid: %s", err.Error())
+	}
+	// FusionAuth may not return the auth token, so only overwrite the
+	// configured value if one was returned.
+	if messenger.AuthToken != "" {
+		if err := data.Set("auth_token", messenger.AuthToken); err != nil {
+			return diag.Errorf("messenger.auth_token: %s", err.Error())
+		}
+	}
+	if err := data.Set("from_phone_number", messenger.FromPhoneNumber); err != nil {
+		return diag.Errorf("messenger.from_phone_number: %s", err.Error())
+	}
+	if err := data.Set("messaging_service_sid", messenger.MessagingServiceSid); err != nil {
+		return diag.Errorf("messenger.messaging_service_sid: %s", err.Error())
+	}
+	if err := data.Set("url", messenger.Url); err != nil {
+		return diag.Errorf("messenger.url: %s", err.Error())
+	}
+
+	return nil
+}
+
+func updateTwilioMessenger(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	_, diags := updateMessenger(ctx, data, i.(Client), buildTwilioMessenger(data))
+	return diags
+}
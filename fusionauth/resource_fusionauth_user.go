@@ -17,6 +17,7 @@ func newUser() *schema.Resource {
 		ReadContext:   readUser,
 		UpdateContext: updateUser,
 		DeleteContext: deleteUser,
+		Timeouts:      defaultResourceTimeouts(),
 		Schema:        userSchemaV1().Schema,
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{{
@@ -30,36 +31,36 @@ func newUser() *schema.Resource {
 	}
 }
 
-func createUser(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func createUser(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client).withContextDeadline()
 	req, diags := dataToUserRequest(data)
 	if diags != nil {
 		return diags
 	}
 
-	oldTenantID := client.FAClient.TenantId
-	client.FAClient.TenantId = req.User.TenantId
-	defer func() {
-		client.FAClient.TenantId = oldTenantID
-	}()
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
 
-	resp, faErrs, err := client.FAClient.CreateUser(req.User.Id, req)
+	resp, faErrs, err := client.FAClient.CreateUserWithContext(ctx, req.User.Id, req)
 	if err != nil {
 		return diag.Errorf("CreateUser err: %v", err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return userResponseToData(data, resp)
 }
 
-func readUser(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readUser(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.RetrieveUser(id)
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
+
+	resp, faErrs, err := client.FAClient.RetrieveUserWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -68,37 +69,43 @@ func readUser(_ context.Context, data *schema.ResourceData, i interface{}) diag.
 		data.SetId("")
 		return nil
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return userResponseToData(data, resp)
 }
 
-func updateUser(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func updateUser(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client).withContextDeadline()
 	req, diags := dataToUserRequest(data)
 	if diags != nil {
 		return diags
 	}
 
-	resp, faErrs, err := client.FAClient.UpdateUser(data.Id(), req)
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
+
+	resp, faErrs, err := client.FAClient.UpdateUserWithContext(ctx, data.Id(), req)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return userResponseToData(data, resp)
 }
 
-func deleteUser(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client := i.(Client)
+func deleteUser(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client).withContextDeadline()
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteUser(id)
+	revertTid := clientTenantIDOverride(&client, data)
+	defer revertTid()
+
+	resp, faErrs, err := client.FAClient.DeleteUserWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -109,8 +116,8 @@ func deleteUser(_ context.Context, data *schema.ResourceData, i interface{}) dia
 		return nil
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
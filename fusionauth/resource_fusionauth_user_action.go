@@ -166,7 +166,7 @@ func createUserAction(ctx context.Context, data *schema.ResourceData, i interfac
 	client := i.(Client)
 	userAction := buildUserAction(data)
 
-	resp, faErrs, err := client.FAClient.CreateUserAction(userAction.Id, fusionauth.UserActionRequest{
+	resp, faErrs, err := client.FAClient.CreateUserActionWithContext(ctx, userAction.Id, fusionauth.UserActionRequest{
 		UserAction: userAction,
 	})
 
@@ -174,19 +174,19 @@ func createUserAction(ctx context.Context, data *schema.ResourceData, i interfac
 		return diag.Errorf("CreateUser err: %v", err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 	data.SetId(resp.UserAction.Id)
 
 	return readUserAction(ctx, data, i)
 }
 
-func readUserAction(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readUserAction(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, err := client.FAClient.RetrieveUserAction(id)
+	resp, err := client.FAClient.RetrieveUserActionWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -253,28 +253,28 @@ func readUserAction(_ context.Context, data *schema.ResourceData, i interface{})
 func updateUserAction(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
-	resp, faErrs, err := client.FAClient.UpdateUserAction(data.Id(), fusionauth.UserActionRequest{
+	resp, faErrs, err := client.FAClient.UpdateUserActionWithContext(ctx, data.Id(), fusionauth.UserActionRequest{
 		UserAction: buildUserAction(data),
 	})
 	if err != nil {
 		return diag.Errorf("UpdateUserAction err: %v", err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return readUserAction(ctx, data, i)
 }
 
-func deleteUserAction(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteUserAction(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
-	resp, faErrs, err := client.FAClient.DeleteUserAction(data.Id())
+	resp, faErrs, err := client.FAClient.DeleteUserActionWithContext(ctx, data.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
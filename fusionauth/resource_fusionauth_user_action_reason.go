@@ -0,0 +1,150 @@
+package fusionauth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceUserActionReason() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createUserActionReason,
+		ReadContext:   readUserActionReason,
+		UpdateContext: updateUserActionReason,
+		DeleteContext: deleteUserActionReason,
+		Schema: map[string]*schema.Schema{
+			"code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "A short code for this User Action Reason.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"localized_texts": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A mapping of localized text for this User Action Reason. The key is the Locale and the value is the text of the User Action Reason for that language.",
+			},
+			"text": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The text of this User Action Reason.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"user_action_reason_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The id of this User Action Reason.",
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func buildUserActionReason(data *schema.ResourceData) fusionauth.UserActionReason {
+	uar := fusionauth.UserActionReason{
+		Code: data.Get("code").(string),
+		Id:   data.Get("user_action_reason_id").(string),
+		Text: data.Get("text").(string),
+	}
+
+	if i, ok := data.GetOk("localized_texts"); ok {
+		uar.LocalizedTexts = intMapToStringMap(i.(map[string]interface{}))
+	}
+
+	return uar
+}
+
+func createUserActionReason(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	reason := buildUserActionReason(data)
+
+	resp, faErrs, err := client.FAClient.CreateUserActionReasonWithContext(ctx, reason.Id, fusionauth.UserActionReasonRequest{
+		UserActionReason: reason,
+	})
+	if err != nil {
+		return diag.Errorf("CreateUserActionReason err: %v", err)
+	}
+
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+	data.SetId(resp.UserActionReason.Id)
+
+	return readUserActionReason(ctx, data, i)
+}
+
+func readUserActionReason(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, err := client.FAClient.RetrieveUserActionReasonWithContext(ctx, data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		data.SetId("")
+		return nil
+	}
+	if err := checkResponse(resp.StatusCode, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	reason := resp.UserActionReason
+	if err := data.Set("code", reason.Code); err != nil {
+		return diag.Errorf("user_action_reason.code: %s", err.Error())
+	}
+	if err := data.Set("localized_texts", reason.LocalizedTexts); err != nil {
+		return diag.Errorf("user_action_reason.localized_texts: %s", err.Error())
+	}
+	if err := data.Set("text", reason.Text); err != nil {
+		return diag.Errorf("user_action_reason.text: %s", err.Error())
+	}
+	if err := data.Set("user_action_reason_id", reason.Id); err != nil {
+		return diag.Errorf("user_action_reason.user_action_reason_id: %s", err.Error())
+	}
+
+	return nil
+}
+
+func updateUserActionReason(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+	reason := buildUserActionReason(data)
+
+	resp, faErrs, err := client.FAClient.UpdateUserActionReasonWithContext(ctx, data.Id(), fusionauth.UserActionReasonRequest{
+		UserActionReason: reason,
+	})
+	if err != nil {
+		return diag.Errorf("UpdateUserActionReason err: %v", err)
+	}
+
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	return readUserActionReason(ctx, data, i)
+}
+
+func deleteUserActionReason(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, faErrs, err := client.FAClient.DeleteUserActionReasonWithContext(ctx, data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	return nil
+}
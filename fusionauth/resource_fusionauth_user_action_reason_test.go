@@ -0,0 +1,57 @@
+package fusionauth
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccUserActionReason(t *testing.T) {
+	resourceName := randString10()
+	tfResourcePath := fmt.Sprintf("fusionauth_user_action_reason.test_%s", resourceName)
+
+	startText, endText := "Violated the terms of service", "Violated the code of conduct"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDestroyed("fusionauth_user_action_reason", "/api/user-action-reason"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserActionReasonConfig(resourceName, startText),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfResourcePath, "code", "TOS_"+resourceName),
+					resource.TestCheckResourceAttr(tfResourcePath, "text", startText),
+					resource.TestCheckResourceAttr(tfResourcePath, "localized_texts.de", "Verstoß gegen die Nutzungsbedingungen"),
+					resource.TestCheckResourceAttrSet(tfResourcePath, "user_action_reason_id"),
+				),
+			},
+			{
+				Config: testAccUserActionReasonConfig(resourceName, endText),
+				Check:  resource.TestCheckResourceAttr(tfResourcePath, "text", endText),
+			},
+			{
+				Config:             testAccUserActionReasonConfig(resourceName, endText),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:      tfResourcePath,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccUserActionReasonConfig(resourceName, text string) string {
+	return fmt.Sprintf(`
+	resource "fusionauth_user_action_reason" "test_%[1]s" {
+		code = "TOS_%[1]s"
+		text = "%[2]s"
+		localized_texts = {
+			"de" = "Verstoß gegen die Nutzungsbedingungen"
+		}
+	}
+	`, resourceName, text)
+}
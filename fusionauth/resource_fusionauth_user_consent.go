@@ -0,0 +1,188 @@
+package fusionauth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func newUserConsent() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Records that a user has given a consent. FusionAuth does not delete user consents, destroying this resource revokes the consent.",
+		CreateContext: createUserConsent,
+		ReadContext:   readUserConsent,
+		UpdateContext: updateUserConsent,
+		DeleteContext: deleteUserConsent,
+		Schema: map[string]*schema.Schema{
+			"consent_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The Id of the Consent being given.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "An object that can hold any information about the User Consent that should be persisted.",
+			},
+			"giver_user_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The Id of the User giving the consent, i.e. a parent consenting for a child. Defaults to user_id.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(fusionauth.ConsentStatus_Active),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(fusionauth.ConsentStatus_Active),
+					string(fusionauth.ConsentStatus_Revoked),
+				}, false),
+				Description: "The status of the User Consent.",
+			},
+			"user_consent_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The Id to use for the new User Consent. If not specified a secure random UUID will be generated.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"user_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The Id of the User who is consenting.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"values": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "The values that are being consented to, for Consents with multiple values.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func buildUserConsent(data *schema.ResourceData) fusionauth.UserConsent {
+	giverUserID := data.Get("giver_user_id").(string)
+	if giverUserID == "" {
+		giverUserID = data.Get("user_id").(string)
+	}
+
+	return fusionauth.UserConsent{
+		ConsentId:   data.Get("consent_id").(string),
+		Data:        data.Get("data").(map[string]interface{}),
+		GiverUserId: giverUserID,
+		Status:      fusionauth.ConsentStatus(data.Get("status").(string)),
+		UserId:      data.Get("user_id").(string),
+		Values:      handleStringSlice("values", data),
+	}
+}
+
+func createUserConsent(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, faErrs, err := client.FAClient.CreateUserConsentWithContext(ctx, data.Get("user_consent_id").(string), fusionauth.UserConsentRequest{
+		UserConsent: buildUserConsent(data),
+	})
+	if err != nil {
+		return diag.Errorf("CreateUserConsent err: %v", err)
+	}
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	data.SetId(resp.UserConsent.Id)
+	return buildResourceDataFromUserConsent(resp.UserConsent, data)
+}
+
+func readUserConsent(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, err := client.FAClient.RetrieveUserConsentWithContext(ctx, data.Id())
+	if err != nil {
+		return diag.Errorf("RetrieveUserConsent err: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		data.SetId("")
+		return nil
+	}
+	if err := checkResponse(resp.StatusCode, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return buildResourceDataFromUserConsent(resp.UserConsent, data)
+}
+
+func updateUserConsent(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, faErrs, err := client.FAClient.UpdateUserConsentWithContext(ctx, data.Id(), fusionauth.UserConsentRequest{
+		UserConsent: buildUserConsent(data),
+	})
+	if err != nil {
+		return diag.Errorf("UpdateUserConsent err: %v", err)
+	}
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
+	}
+
+	return buildResourceDataFromUserConsent(resp.UserConsent, data)
+}
+
+func deleteUserConsent(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(Client)
+
+	resp, err := client.FAClient.RevokeUserConsentWithContext(ctx, data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err := checkResponse(resp.StatusCode, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func buildResourceDataFromUserConsent(uc fusionauth.UserConsent, data *schema.ResourceData) diag.Diagnostics {
+	if err := data.Set("consent_id", uc.ConsentId); err != nil {
+		return diag.Errorf("user_consent.consent_id: %s", err.Error())
+	}
+	if err := data.Set("data", uc.Data); err != nil {
+		return diag.Errorf("user_consent.data: %s", err.Error())
+	}
+	if err := data.Set("giver_user_id", uc.GiverUserId); err != nil {
+		return diag.Errorf("user_consent.giver_user_id: %s", err.Error())
+	}
+	if err := data.Set("status", string(uc.Status)); err != nil {
+		return diag.Errorf("user_consent.status: %s", err.Error())
+	}
+	if err := data.Set("user_consent_id", uc.Id); err != nil {
+		return diag.Errorf("user_consent.user_consent_id: %s", err.Error())
+	}
+	if err := data.Set("user_id", uc.UserId); err != nil {
+		return diag.Errorf("user_consent.user_id: %s", err.Error())
+	}
+	if err := data.Set("values", uc.Values); err != nil {
+		return diag.Errorf("user_consent.values: %s", err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package fusionauth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/FusionAuth/go-client/pkg/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccUserConsent(t *testing.T) {
+	resourceName := randString10()
+	tfResourcePath := fmt.Sprintf("fusionauth_user_consent.test_%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckUserConsentRevoked,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConsentConfig(resourceName, `["email"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfResourcePath, "status", "Active"),
+					resource.TestCheckResourceAttr(tfResourcePath, "values.#", "1"),
+					resource.TestCheckResourceAttrPair(tfResourcePath, "giver_user_id", "fusionauth_user.test_"+resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccUserConsentConfig(resourceName, `["email", "sms"]`),
+				Check:  resource.TestCheckResourceAttr(tfResourcePath, "values.#", "2"),
+			},
+			{
+				Config:             testAccUserConsentConfig(resourceName, `["email", "sms"]`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:      tfResourcePath,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccUserConsentConfig(resourceName, values string) string {
+	return testAccUserResourceConfigBase(resourceName) + fmt.Sprintf(`
+	resource "fusionauth_consent" "test_%[1]s" {
+		name                                 = "Marketing %[1]s"
+		default_minimum_age_for_self_consent = 13
+		multiple_values_allowed              = true
+		values                               = ["email", "sms"]
+	}
+
+	resource "fusionauth_user" "test_%[1]s" {
+		email     = "consent-%[1]s@example.com"
+		tenant_id = fusionauth_tenant.test_%[1]s.id
+	}
+
+	resource "fusionauth_user_consent" "test_%[1]s" {
+		consent_id = fusionauth_consent.test_%[1]s.id
+		user_id    = fusionauth_user.test_%[1]s.id
+		values     = %[2]s
+	}
+	`, resourceName, values)
+}
+
+// testAccCheckUserConsentRevoked checks that every user consent of the state
+// has been revoked, as FusionAuth does not delete user consents.
+func testAccCheckUserConsentRevoked(s *terraform.State) error {
+	client := fusionauthClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fusionauth_user_consent" {
+			continue
+		}
+
+		resp, err := client.RetrieveUserConsentWithContext(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if resp.UserConsent.Status != fusionauth.ConsentStatus_Revoked {
+			return fmt.Errorf("fusionauth_user_consent %s has not been revoked", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func Test_userConsent_selfConsent(t *testing.T) {
+	fake, client := newFakeClient(t)
+	ctx := context.Background()
+
+	userID := fake.create("user", map[string]interface{}{})
+
+	data := newUserConsent().TestResourceData()
+	_ = data.Set("consent_id", fake.create("consent", map[string]interface{}{}))
+	_ = data.Set("user_id", userID)
+
+	if diags := createUserConsent(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if got := data.Get("giver_user_id"); got != userID {
+		t.Errorf("giver_user_id = %q, want %q", got, userID)
+	}
+	if got := data.Get("user_consent_id"); got != data.Id() {
+		t.Errorf("user_consent_id = %q, want %q", got, data.Id())
+	}
+
+	delete(fake.collection("user-consent"), data.Id())
+	if diags := readUserConsent(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if data.Id() != "" {
+		t.Error("expected a user consent removed outside Terraform to be removed from the state")
+	}
+}
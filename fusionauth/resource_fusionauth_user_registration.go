@@ -8,7 +8,6 @@ import (
 	"io"
 	"net/http"
 	"strings"
-	"time"
 
 	"github.com/FusionAuth/go-client/pkg/fusionauth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -105,7 +104,7 @@ func buildRegistration(data *schema.ResourceData) fusionauth.RegistrationRequest
 	}
 }
 
-func createRegistration(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createRegistration(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	reg := struct {
 		Registration                 fusionauth.UserRegistration `json:"registration,omitempty"`
 		SkipRegistrationVerification bool                        `json:"skipRegistrationVerification"`
@@ -124,7 +123,7 @@ func createRegistration(_ context.Context, data *schema.ResourceData, i interfac
 
 	client := i.(Client)
 	b, _ := json.Marshal(reg)
-	b, err := sendCreateRegistration(b, data.Get("user_id").(string), data.Get("application_id").(string), client)
+	b, err := sendCreateRegistration(ctx, b, data.Get("user_id").(string), data.Get("application_id").(string), client)
 	if err != nil {
 		return diag.Errorf("register err: %v", err)
 	}
@@ -135,8 +134,9 @@ func createRegistration(_ context.Context, data *schema.ResourceData, i interfac
 	return buildResourceDataFromRegistration(reg.Registration, data)
 }
 
-func sendCreateRegistration(b []byte, uid string, aid string, client Client) ([]byte, error) {
-	req, err := http.NewRequest(
+func sendCreateRegistration(ctx context.Context, b []byte, uid string, aid string, client Client) ([]byte, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf("%s/%s/%s/%s", strings.TrimRight(client.Host, "/"), "api/user/registration", uid, aid),
 		bytes.NewBuffer(b),
@@ -146,14 +146,10 @@ func sendCreateRegistration(b []byte, uid string, aid string, client Client) ([]
 		return nil, err
 	}
 
-	req.Header.Add("Authorization", client.APIKey)
+	client.addRequestHeaders(req)
 	req.Header.Add("Content-Type", "application/json")
 
-	hc := http.Client{
-		Timeout: 30 * time.Second,
-	}
-
-	resp, err := hc.Do(req)
+	resp, err := client.FAClient.HTTPClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -167,10 +163,10 @@ func sendCreateRegistration(b []byte, uid string, aid string, client Client) ([]
 	return b, nil
 }
 
-func readRegistration(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readRegistration(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
-	resp, faErrs, err := client.FAClient.RetrieveRegistration(data.Get("user_id").(string), data.Get("application_id").(string))
+	resp, faErrs, err := client.FAClient.RetrieveRegistrationWithContext(ctx, data.Get("user_id").(string), data.Get("application_id").(string))
 	if err != nil {
 		return diag.Errorf("RetrieveRegistration err: %v", err)
 	}
@@ -179,8 +175,8 @@ func readRegistration(_ context.Context, data *schema.ResourceData, i interface{
 		data.SetId("")
 		return nil
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return buildResourceDataFromRegistration(resp.Registration, data)
@@ -212,32 +208,32 @@ func buildResourceDataFromRegistration(r fusionauth.UserRegistration, data *sche
 	return nil
 }
 
-func updateRegistration(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateRegistration(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	ur := buildRegistration(data)
 
-	resp, faErrs, err := client.FAClient.UpdateRegistration(data.Get("user_id").(string), ur)
+	resp, faErrs, err := client.FAClient.UpdateRegistrationWithContext(ctx, data.Get("user_id").(string), ur)
 	if err != nil {
 		return diag.Errorf("UpdateRegistration err: %v", err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
 }
 
-func deleteRegistration(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteRegistration(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 
-	resp, faErrs, err := client.FAClient.DeleteRegistration(data.Get("user_id").(string), data.Get("application_id").(string))
+	resp, faErrs, err := client.FAClient.DeleteRegistrationWithContext(ctx, data.Get("user_id").(string), data.Get("application_id").(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 	return nil
 }
@@ -540,3 +540,34 @@ func testAccUserResourceConfigBase(resourceName string) string {
 			false,
 		)
 }
+
+func Test_user_otherTenant(t *testing.T) {
+	fake, client := newFakeClient(t)
+	ctx := context.Background()
+
+	providerTenantID := fake.create("tenant", map[string]interface{}{"name": "Default"})
+	tenantID := fake.create("tenant", map[string]interface{}{"name": "Other"})
+	userID := fake.create("user", map[string]interface{}{"email": "jane@example.com", "tenantId": tenantID})
+	client.FAClient.TenantId = providerTenantID
+
+	data := newUser().TestResourceData()
+	data.SetId(userID)
+	_ = data.Set("tenant_id", tenantID)
+
+	if diags := readUser(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if data.Id() != userID {
+		t.Fatal("expected the user of another tenant than the provider's to be read")
+	}
+	if got := data.Get("email"); got != "jane@example.com" {
+		t.Errorf("email = %q", got)
+	}
+
+	if diags := deleteUser(ctx, data, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if _, ok := fake.collection("user")[userID]; ok {
+		t.Error("expected the user of another tenant than the provider's to be deleted")
+	}
+}
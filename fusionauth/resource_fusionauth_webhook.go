@@ -15,6 +15,7 @@ func newWebhook() *schema.Resource {
 		ReadContext:   readWebhook,
 		UpdateContext: updateWebhook,
 		DeleteContext: deleteWebhook,
+		CustomizeDiff: customizeDiffMinVersions(webhookMinVersions),
 		Schema: map[string]*schema.Schema{
 			"tenant_ids": {
 				Type:        schema.TypeSet,
@@ -354,28 +355,47 @@ func buildEventsEnabled(key string, data *schema.ResourceData) map[fusionauth.Ev
 	}
 }
 
-func createWebhook(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+// webhookMinVersions returns the events that require a newer FusionAuth
+// version than the oldest one supported by the provider.
+func webhookMinVersions() map[string]string {
+	return map[string]string{
+		"events_enabled.0.user_email_update":             "1.30.0",
+		"events_enabled.0.user_identity_provider_link":   "1.36.0",
+		"events_enabled.0.user_identity_provider_unlink": "1.36.0",
+		"events_enabled.0.user_login_new_device":         "1.30.0",
+		"events_enabled.0.user_login_suspicious":         "1.30.0",
+		"events_enabled.0.user_password_reset_send":      "1.30.0",
+		"events_enabled.0.user_password_reset_start":     "1.30.0",
+		"events_enabled.0.user_password_reset_success":   "1.30.0",
+		"events_enabled.0.user_password_update":          "1.30.0",
+		"events_enabled.0.user_two_factor_method_add":    "1.30.0",
+		"events_enabled.0.user_two_factor_method_remove": "1.30.0",
+	}
+}
+
+func createWebhook(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
+	warnings := unsupportedAttributeWarnings(data, client, webhookMinVersions())
 	l := buildWebhook(data)
-	resp, faErrs, err := client.FAClient.CreateWebhook("", fusionauth.WebhookRequest{
+	resp, faErrs, err := client.FAClient.CreateWebhookWithContext(ctx, "", fusionauth.WebhookRequest{
 		Webhook: l,
 	})
 	if err != nil {
 		return diag.Errorf("CreateWebhook err: %v", err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return append(warnings, diags...)
 	}
 	data.SetId(resp.Webhook.Id)
-	return nil
+	return warnings
 }
 
-func readWebhook(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readWebhook(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, err := client.FAClient.RetrieveWebhook(id)
+	resp, err := client.FAClient.RetrieveWebhookWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -471,35 +491,36 @@ func readWebhook(_ context.Context, data *schema.ResourceData, i interface{}) di
 	return nil
 }
 
-func updateWebhook(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateWebhook(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
+	warnings := unsupportedAttributeWarnings(data, client, webhookMinVersions())
 	l := buildWebhook(data)
 
-	resp, faErrs, err := client.FAClient.UpdateWebhook(data.Id(), fusionauth.WebhookRequest{
+	resp, faErrs, err := client.FAClient.UpdateWebhookWithContext(ctx, data.Id(), fusionauth.WebhookRequest{
 		Webhook: l,
 	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return append(warnings, diags...)
 	}
 
-	return nil
+	return warnings
 }
 
-func deleteWebhook(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteWebhook(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	id := data.Id()
 
-	resp, faErrs, err := client.FAClient.DeleteWebhook(id)
+	resp, faErrs, err := client.FAClient.DeleteWebhookWithContext(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 
 	return nil
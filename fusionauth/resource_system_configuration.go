@@ -191,15 +191,15 @@ func resourceSystemConfiguration() *schema.Resource {
 	}
 }
 
-func createSystemConfiguration(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func createSystemConfiguration(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
 	data.SetId("syscfg")
-	return updateSysCfg(buildSystemConfigurationRequest(data), client)
+	return updateSysCfg(ctx, data, buildSystemConfigurationRequest(data), client)
 }
 
-func readSystemConfiguration(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func readSystemConfiguration(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	resp, err := client.FAClient.RetrieveSystemConfiguration()
+	resp, err := client.FAClient.RetrieveSystemConfigurationWithContext(ctx)
 	if err != nil {
 		return diag.Errorf("RetrieveSystemConfiguration err: %v", err)
 	}
@@ -215,23 +215,23 @@ func readSystemConfiguration(_ context.Context, data *schema.ResourceData, i int
 	return buildResourceFromSystemConfiguration(resp.SystemConfiguration, data)
 }
 
-func updateSystemConfiguration(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+func updateSystemConfiguration(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	return updateSysCfg(buildSystemConfigurationRequest(data), client)
+	return updateSysCfg(ctx, data, buildSystemConfigurationRequest(data), client)
 }
 
-func deleteSystemConfiguration(_ context.Context, _ *schema.ResourceData, i interface{}) diag.Diagnostics {
+func deleteSystemConfiguration(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := i.(Client)
-	return updateSysCfg(getDefaultSystemConfigurationRequest(), client)
+	return updateSysCfg(ctx, data, getDefaultSystemConfigurationRequest(), client)
 }
 
-func updateSysCfg(req fusionauth.SystemConfigurationRequest, client Client) diag.Diagnostics {
-	resp, faErrs, err := client.FAClient.UpdateSystemConfiguration(req)
+func updateSysCfg(ctx context.Context, data *schema.ResourceData, req fusionauth.SystemConfigurationRequest, client Client) diag.Diagnostics {
+	resp, faErrs, err := client.FAClient.UpdateSystemConfigurationWithContext(ctx, req)
 	if err != nil {
 		return diag.Errorf("UpdateSystemConfiguration err: %v", err)
 	}
-	if err := checkResponse(resp.StatusCode, faErrs); err != nil {
-		return diag.FromErr(err)
+	if diags := checkResponseDiagnostics(data, resp.StatusCode, faErrs); diags != nil {
+		return diags
 	}
 	return nil
 }
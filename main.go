@@ -3,13 +3,20 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/gpsinsight/terraform-provider-fusionauth/fusionauth"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		os.Exit(export(os.Args[2:]))
+	}
+
 	var debugMode bool
 
 	flag.BoolVar(&debugMode, "debuggable", false, "set to true to run the provider with support for debuggers like delve")
@@ -27,3 +34,53 @@ func main() {
 
 	plugin.Serve(opts)
 }
+
+// export writes the configuration of a FusionAuth instance as Terraform
+// configuration. The API key is read from FA_API_KEY, so it does not end up
+// in the shell history, the remaining provider settings from their
+// environment variables.
+func export(args []string) int {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s export [-host URL] [-out FILE]\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Writes Terraform configuration and import blocks for an existing FusionAuth instance. The API key is read from FA_API_KEY.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	host := flags.String("host", "", "the URL of FusionAuth, defaults to FA_DOMAIN")
+	out := flags.String("out", "", "the file to write the configuration to, defaults to stdout")
+	_ = flags.Parse(args)
+
+	config := map[string]interface{}{}
+	if *host != "" {
+		config["host"] = *host
+	}
+
+	w := os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		defer f.Close()
+		w = f
+	}
+
+	diags := fusionauth.Export(context.Background(), w, config)
+	for _, d := range diags {
+		severity := "Warning"
+		if d.Severity == diag.Error {
+			severity = "Error"
+		}
+		fmt.Fprintf(os.Stderr, "%s: %s\n", severity, d.Summary)
+		if d.Detail != "" {
+			fmt.Fprintf(os.Stderr, "  %s\n", d.Detail)
+		}
+	}
+	if diags.HasError() {
+		return 1
+	}
+
+	return 0
+}